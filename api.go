@@ -0,0 +1,98 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"hw-3/grpcapi"
+	"hw-3/monitor"
+	"hw-3/stream"
+)
+
+const (
+	shutdownTimeout = 5 * time.Second
+)
+
+func writeJSON(w http.ResponseWriter, status int, value interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(value); err != nil {
+		log.Errorf("Failed writing response: %s", err)
+	}
+}
+
+func gasHandler(mon *monitor.Monitor) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		estimate := mon.GasEstimate()
+		if estimate == nil {
+			writeJSON(w, http.StatusServiceUnavailable, map[string]string{"error": "no blocks observed yet"})
+			return
+		}
+		writeJSON(w, http.StatusOK, estimate)
+	}
+}
+
+func serveAPI(ctx context.Context, listenAddress string, mon *monitor.Monitor, hub *stream.Hub, wg *sync.WaitGroup) {
+	defer wg.Done()
+
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.Handler())
+	mux.Handle("/gas", gasHandler(mon))
+	mux.Handle("/stream", hub.SSEHandler())
+	mux.Handle("/ws", hub.WebsocketHandler())
+
+	server := &http.Server{Addr: listenAddress, Handler: mux}
+	// Streams never end on their own, disconnect them so shutdown does not wait for them
+	server.RegisterOnShutdown(func() { hub.Close() })
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+		defer cancel()
+		server.Shutdown(shutdownCtx)
+	}()
+
+	log.WithField("address", listenAddress).Info("Serving API")
+	if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		log.Errorf("Failed serving API: %s", err)
+	}
+}
+
+func serveGRPC(ctx context.Context, listenAddress string, mon *monitor.Monitor, hub *stream.Hub, wg *sync.WaitGroup) {
+	defer wg.Done()
+
+	listener, err := net.Listen("tcp", listenAddress)
+	if err != nil {
+		log.Errorf("Failed listening for gRPC: %s", err)
+		return
+	}
+	server := grpc.NewServer()
+	grpcapi.NewServer(mon, hub).Register(server)
+	go func() {
+		<-ctx.Done()
+		// Watch streams never end on their own, end them so GracefulStop does not wait for them
+		hub.Close()
+		stopped := make(chan struct{})
+		go func() {
+			server.GracefulStop()
+			close(stopped)
+		}()
+		select {
+		case <-stopped:
+		case <-time.After(shutdownTimeout):
+			server.Stop()
+		}
+	}()
+
+	log.WithField("address", listenAddress).Info("Serving gRPC API")
+	if err := server.Serve(listener); err != nil {
+		log.Errorf("Failed serving gRPC API: %s", err)
+	}
+}
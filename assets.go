@@ -0,0 +1,56 @@
+package main
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// mainnetFeedRegistry is the address of the Chainlink Feed Registry on the
+// Ethereum mainnet, it is used unless a feed specifies its own registry.
+const mainnetFeedRegistry = "0x47Fb2585D2C56Fe188D0E6ec628a38b74fCeeeDf"
+
+// assets maps the symbols accepted as base and quote of registry feeds to
+// the addresses the registry knows them by. Like in Chainlink's Denominations
+// library ETH and BTC have placeholder addresses and fiat currencies are
+// addressed by their ISO 4217 numeric code.
+var assets = map[string]common.Address{
+	"ETH": common.HexToAddress("0xEeeeeEeeeEeEeeEeEeEeeEEEeeeeEeeeeeeeEEeE"),
+	"BTC": common.HexToAddress("0xbBbBBBBbbBBBbbbBbbBbbbbBBbBbbbbBbBbbBBbB"),
+
+	"USD": common.BigToAddress(big.NewInt(840)),
+	"EUR": common.BigToAddress(big.NewInt(978)),
+	"GBP": common.BigToAddress(big.NewInt(826)),
+	"JPY": common.BigToAddress(big.NewInt(392)),
+	"CHF": common.BigToAddress(big.NewInt(756)),
+	"AUD": common.BigToAddress(big.NewInt(36)),
+	"CAD": common.BigToAddress(big.NewInt(124)),
+
+	"LINK": common.HexToAddress("0x514910771AF9Ca656af840dff83E8264EcF986CA"),
+	"AAVE": common.HexToAddress("0x7Fc66500c84A76Ad7e9c93437bFc5Ac33E2DDaE9"),
+	"UNI":  common.HexToAddress("0x1f9840a85d5aF5bf1D1762F925BDADdC4201F984"),
+	"MKR":  common.HexToAddress("0x9f8F72aA9304c8B593d555F12eF6589cC3A579A2"),
+	"COMP": common.HexToAddress("0xc00e94Cb662C3520282E6f5717214004A7f26888"),
+	"SNX":  common.HexToAddress("0xC011a73ee8576Fb46F5E1c5751cA3B9Fe0af2a6F"),
+	"CRV":  common.HexToAddress("0xD533a949740bb3306d119CC777fa900bA034cd52"),
+	"YFI":  common.HexToAddress("0x0bc529c00C6401aEF6D220BE8C6Ea1667F6Ad93e"),
+	"USDC": common.HexToAddress("0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48"),
+	"USDT": common.HexToAddress("0xdAC17F958D2ee523a2206206994597C13D831ec7"),
+	"DAI":  common.HexToAddress("0x6B175474E89094C44Da98b954EedeAC495271d0F"),
+	"WBTC": common.HexToAddress("0x2260FAC5E5542a773Aa44fBCfeDf7C193bc2C599"),
+}
+
+// resolveAsset returns the address of an asset given by its symbol or by an
+// address.
+func resolveAsset(asset string) (common.Address, error) {
+	if common.IsHexAddress(asset) {
+		return common.HexToAddress(asset), nil
+	}
+	address, ok := assets[strings.ToUpper(asset)]
+	if !ok {
+		return common.Address{}, fmt.Errorf("unknown asset %q", asset)
+	}
+	return address, nil
+}
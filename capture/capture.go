@@ -0,0 +1,98 @@
+// Package capture records everything a backend delivers to the monitor into a
+// compressed file and replays it later without a node.
+//
+// A capture is a gzip compressed stream of JSON lines, one entry per header,
+// log, pending transaction hash, block, contract call, transaction, receipt
+// or subscription, each stamped with its offset from the start of the
+// recording. Replaying feeds the entries back through the regular monitor
+// pipeline in the original order.
+package capture
+
+import (
+	"encoding/json"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"hw-3/monitor"
+)
+
+type entryKind string
+
+const (
+	entrySubscribe entryKind = "subscribe"
+	entryHeader    entryKind = "header"
+	entryLog       entryKind = "log"
+	entryBlock     entryKind = "block"
+	entryCall      entryKind = "call"
+	entryTx        entryKind = "transaction"
+	entryReceipt   entryKind = "receipt"
+	entryPending   entryKind = "pending"
+)
+
+const (
+	subscriptionHeads   = "newHeads"
+	subscriptionLogs    = "logs"
+	subscriptionPending = "newPendingTransactions"
+)
+
+// Backend is what the monitor needs from a node to watch both contracts and
+// blocks, e.g. *ethclient.Client. Transactions are only recorded if it also
+// implements monitor.TransactionBackend and pending transactions if it
+// implements monitor.PendingBackend.
+type Backend interface {
+	bind.ContractBackend
+	monitor.BlockBackend
+}
+
+type call struct {
+	To     common.Address `json:"to"`
+	Data   hexutil.Bytes  `json:"data"`
+	Result hexutil.Bytes  `json:"result,omitempty"`
+	Err    string         `json:"error,omitempty"`
+}
+
+func (c *call) key() string {
+	return c.To.Hex() + c.Data.String()
+}
+
+// transaction is a transaction in its binary encoding, Pending tells whether
+// it was not mined yet when it was looked up.
+type transaction struct {
+	Data    hexutil.Bytes `json:"data"`
+	Pending bool          `json:"pending,omitempty"`
+}
+
+// entry is a line of a capture, Offset is in nanoseconds.
+type entry struct {
+	Offset       int64                 `json:"offset"`
+	Kind         entryKind             `json:"kind"`
+	Subscription string                `json:"subscription,omitempty"`
+	Query        *ethereum.FilterQuery `json:"query,omitempty"`
+	Header       *types.Header         `json:"header,omitempty"`
+	Log          *types.Log            `json:"log,omitempty"`
+	Block        hexutil.Bytes         `json:"block,omitempty"`
+	Call         *call                 `json:"call,omitempty"`
+	Tx           *transaction          `json:"transaction,omitempty"`
+	Receipt      *types.Receipt        `json:"receipt,omitempty"`
+	PendingHash  *common.Hash          `json:"pendingHash,omitempty"`
+}
+
+// subscriptionKey identifies a subscription by what it filters, so
+// resubscriptions of the same query are recorded once.
+func subscriptionKey(subscription string, query *ethereum.FilterQuery) string {
+	if query == nil {
+		return subscription
+	}
+	encoded, _ := json.Marshal(query)
+	return subscription + string(encoded)
+}
+
+// logKey identifies a log delivered to several subscriptions.
+type logKey struct {
+	block   common.Hash
+	index   uint
+	removed bool
+}
@@ -0,0 +1,294 @@
+package capture_test
+
+import (
+	"context"
+	"math/big"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/ethclient/gethclient"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/rpc"
+	"hw-3/capture"
+	"hw-3/fakenode"
+	"hw-3/monitor"
+	"hw-3/simchain"
+)
+
+const eventTimeout = 10 * time.Second
+
+// runMonitor starts a monitor stopped at the end of the test.
+func runMonitor(t *testing.T, config monitor.Config, backend bind.ContractBackend) (<-chan monitor.Event, context.CancelFunc) {
+	t.Helper()
+	mon := monitor.New(config, backend)
+	ctx, cancel := context.WithCancel(context.Background())
+	go mon.Run(ctx)
+	t.Cleanup(func() {
+		cancel()
+		for range mon.Events() {
+		}
+	})
+	return mon.Events(), cancel
+}
+
+// collect waits for count events of type T, failing on error events.
+func collect[T monitor.Event](t *testing.T, events <-chan monitor.Event, count int) []T {
+	t.Helper()
+	timeout := time.After(eventTimeout)
+	collected := make([]T, 0, count)
+	for len(collected) < count {
+		select {
+		case ev := <-events:
+			if err, ok := ev.(error); ok {
+				t.Fatalf("unexpected error event: %s", err)
+			}
+			if typed, ok := ev.(T); ok {
+				collected = append(collected, typed)
+			}
+		case <-timeout:
+			var zero T
+			t.Fatalf("timed out waiting for %T, got %d of %d", zero, len(collected), count)
+		}
+	}
+	return collected
+}
+
+func TestRecordReplay(t *testing.T) {
+	chain, err := simchain.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer chain.Close()
+	feed, err := chain.DeployFeed(8, big.NewInt(100))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	path := filepath.Join(t.TempDir(), "capture.jsonl.gz")
+	recorder, err := capture.NewRecorder(path, chain.Backend)
+	if err != nil {
+		t.Fatal(err)
+	}
+	config := monitor.Config{
+		Feeds:        []monitor.Feed{{Tokens: "ETH / USD", Address: feed.Address}},
+		PollInterval: 50 * time.Millisecond,
+	}
+	events, stop := runMonitor(t, config, recorder)
+	collect[*monitor.Subscribed](t, events, 3)
+	for _, answer := range []int64{200, 300} {
+		if err := chain.PushAnswer(feed.Aggregator, big.NewInt(answer)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	recorded := collect[*monitor.PriceUpdate](t, events, 2)
+	stop()
+	for range events {
+	}
+	if err := recorder.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	replay, err := capture.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	events, _ = runMonitor(t, config, replay)
+	go replay.Play(context.Background(), 0)
+	replayed := collect[*monitor.PriceUpdate](t, events, 2)
+	for i := range recorded {
+		if replayed[i].RoundID.Cmp(recorded[i].RoundID) != 0 || replayed[i].Answer.Cmp(recorded[i].Answer) != 0 {
+			t.Errorf("expected round %s with answer %s, got round %s with answer %s",
+				recorded[i].RoundID, recorded[i].Answer, replayed[i].RoundID, replayed[i].Answer)
+		}
+		if replayed[i].Aggregator != feed.Aggregator.Address {
+			t.Errorf("expected aggregator %s, got %s", feed.Aggregator.Address, replayed[i].Aggregator)
+		}
+	}
+}
+
+func TestReplayUpdateCosts(t *testing.T) {
+	chain, err := simchain.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer chain.Close()
+	feed, err := chain.DeployFeed(8, big.NewInt(100))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	path := filepath.Join(t.TempDir(), "capture.jsonl.gz")
+	recorder, err := capture.NewRecorder(path, chain.Backend)
+	if err != nil {
+		t.Fatal(err)
+	}
+	config := monitor.Config{
+		Feeds:        []monitor.Feed{{Tokens: "ETH / USD", Address: feed.Address}},
+		UpdateCosts:  true,
+		PollInterval: 50 * time.Millisecond,
+	}
+	events, stop := runMonitor(t, config, recorder)
+	collect[*monitor.Subscribed](t, events, 3)
+	for _, answer := range []int64{200, 300} {
+		if err := chain.PushAnswer(feed.Aggregator, big.NewInt(answer)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	recorded := collect[*monitor.UpdateCost](t, events, 2)
+	stop()
+	for range events {
+	}
+	if err := recorder.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	replay, err := capture.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	events, _ = runMonitor(t, config, replay)
+	go replay.Play(context.Background(), 0)
+	replayed := collect[*monitor.UpdateCost](t, events, 2)
+	for i := range recorded {
+		if replayed[i].TxHash != recorded[i].TxHash || replayed[i].Transmitter != recorded[i].Transmitter ||
+			replayed[i].GasUsed != recorded[i].GasUsed || replayed[i].Cost.Cmp(recorded[i].Cost) != 0 {
+			t.Errorf("expected %d gas costing %s ETH in %s, got %d gas costing %s ETH in %s",
+				recorded[i].GasUsed, recorded[i].Cost, recorded[i].TxHash, replayed[i].GasUsed, replayed[i].Cost, replayed[i].TxHash)
+		}
+	}
+}
+
+func TestReplayBlocks(t *testing.T) {
+	chain, err := simchain.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer chain.Close()
+
+	path := filepath.Join(t.TempDir(), "capture.jsonl.gz")
+	recorder, err := capture.NewRecorder(path, chain.Backend)
+	if err != nil {
+		t.Fatal(err)
+	}
+	events, stop := runMonitor(t, monitor.Config{}, recorder)
+	collect[*monitor.Subscribed](t, events, 1)
+	hashes := []common.Hash{chain.Commit(), chain.Commit()}
+	collect[*monitor.NewBlock](t, events, len(hashes))
+	stop()
+	for range events {
+	}
+	if err := recorder.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	replay, err := capture.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	events, _ = runMonitor(t, monitor.Config{}, replay)
+	go replay.Play(context.Background(), 0)
+	for i, block := range collect[*monitor.NewBlock](t, events, len(hashes)) {
+		if block.Hash != hashes[i] {
+			t.Errorf("expected block %s, got %s", hashes[i], block.Hash)
+		}
+	}
+	if estimate := collect[*monitor.GasUpdate](t, events, 1)[0].Estimate; estimate.NextBaseFee.Sign() <= 0 {
+		t.Errorf("expected positive next base fee, got %s", estimate.NextBaseFee)
+	}
+}
+
+// pendingClient adds the pending transaction subscription of the geth API to
+// ethclient.
+type pendingClient struct {
+	*ethclient.Client
+	geth *gethclient.Client
+}
+
+func (c *pendingClient) SubscribePendingTransactions(ctx context.Context, ch chan<- common.Hash) (ethereum.Subscription, error) {
+	return c.geth.SubscribePendingTransactions(ctx, ch)
+}
+
+// nextPending looks up the next pending transaction delivered to hashes.
+func nextPending(t *testing.T, backend monitor.PendingBackend, hashes <-chan common.Hash) *types.Transaction {
+	t.Helper()
+	select {
+	case hash := <-hashes:
+		tx, isPending, err := backend.TransactionByHash(context.Background(), hash)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !isPending {
+			t.Errorf("expected %s to be pending", hash)
+		}
+		return tx
+	case <-time.After(eventTimeout):
+		t.Fatal("timed out waiting for pending transaction")
+		return nil
+	}
+}
+
+func TestReplayPendingTransactions(t *testing.T) {
+	node, err := fakenode.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer node.Close()
+	rpcClient, err := rpc.DialContext(context.Background(), node.WebsocketURL())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rpcClient.Close()
+
+	path := filepath.Join(t.TempDir(), "capture.jsonl.gz")
+	recorder, err := capture.NewRecorder(path, &pendingClient{Client: ethclient.NewClient(rpcClient), geth: gethclient.New(rpcClient)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	hashes := make(chan common.Hash)
+	sub, err := recorder.SubscribePendingTransactions(context.Background(), hashes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	to := common.Address{1}
+	tx, err := types.SignNewTx(key, types.LatestSignerForChainID(big.NewInt(1)), &types.DynamicFeeTx{
+		ChainID:   big.NewInt(1),
+		To:        &to,
+		Gas:       21000,
+		GasFeeCap: big.NewInt(params.GWei * 20),
+		GasTipCap: big.NewInt(params.GWei),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	node.PushPendingTransaction(tx)
+	nextPending(t, recorder, hashes)
+	sub.Unsubscribe()
+	if err := recorder.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	replay, err := capture.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sub, err = replay.SubscribePendingTransactions(context.Background(), hashes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer sub.Unsubscribe()
+	go replay.Play(context.Background(), 0)
+	if replayed := nextPending(t, replay, hashes); replayed.Hash() != tx.Hash() {
+		t.Errorf("expected pending transaction %s, got %s", tx.Hash(), replayed.Hash())
+	}
+}
@@ -0,0 +1,261 @@
+package capture
+
+import (
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+	"github.com/ethereum/go-ethereum/rlp"
+	"hw-3/monitor"
+)
+
+// recordedLogBlocks is how many blocks below the highest block seen recorded
+// logs are remembered. Copies of a log delivered to several subscriptions and
+// its removal by a reorg arrive well within that distance.
+const recordedLogBlocks = 128
+
+var (
+	errNoTransactions = errors.New("backend doesn't support looking up transactions")
+	errNoPending      = errors.New("backend doesn't support subscribing to pending transactions")
+)
+
+// Recorder is a Backend writing every header, log, pending transaction hash,
+// block, contract call result, transaction and receipt passing through it to
+// a capture file.
+type Recorder struct {
+	Backend
+
+	file  *os.File
+	gzip  *gzip.Writer
+	start time.Time
+
+	mu            sync.Mutex
+	encoder       *json.Encoder
+	err           error
+	subscriptions map[string]bool
+	logs          map[logKey]uint64
+	logsHead      uint64
+}
+
+// NewRecorder creates the capture file at path and records everything
+// backend returns.
+func NewRecorder(path string, backend Backend) (*Recorder, error) {
+	file, err := os.Create(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed creating capture file %s: %w", path, err)
+	}
+	gzipWriter := gzip.NewWriter(file)
+	return &Recorder{
+		Backend:       backend,
+		file:          file,
+		gzip:          gzipWriter,
+		start:         time.Now(),
+		encoder:       json.NewEncoder(gzipWriter),
+		subscriptions: make(map[string]bool),
+		logs:          make(map[logKey]uint64),
+	}, nil
+}
+
+// Close flushes the capture file. It returns the first error writing the
+// capture, if any.
+func (r *Recorder) Close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := r.gzip.Close(); err != nil && r.err == nil {
+		r.err = err
+	}
+	if err := r.file.Close(); err != nil && r.err == nil {
+		r.err = err
+	}
+	return r.err
+}
+
+func (r *Recorder) write(e *entry) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.err != nil {
+		return
+	}
+	e.Offset = int64(time.Since(r.start))
+	if err := r.encoder.Encode(e); err != nil {
+		r.err = fmt.Errorf("failed writing capture: %w", err)
+	}
+}
+
+func (r *Recorder) recordSubscription(subscription string, query *ethereum.FilterQuery) {
+	key := subscriptionKey(subscription, query)
+	r.mu.Lock()
+	recorded := r.subscriptions[key]
+	r.subscriptions[key] = true
+	r.mu.Unlock()
+	if !recorded {
+		r.write(&entry{Kind: entrySubscribe, Subscription: subscription, Query: query})
+	}
+}
+
+func (r *Recorder) recordLog(vLog types.Log) {
+	key := logKey{block: vLog.BlockHash, index: vLog.Index, removed: vLog.Removed}
+	r.mu.Lock()
+	_, recorded := r.logs[key]
+	if !recorded {
+		r.logs[key] = vLog.BlockNumber
+		if vLog.BlockNumber > r.logsHead {
+			r.logsHead = vLog.BlockNumber
+			r.forgetLogs()
+		}
+	}
+	r.mu.Unlock()
+	if !recorded {
+		r.write(&entry{Kind: entryLog, Log: &vLog})
+	}
+}
+
+// forgetLogs drops the logs more than recordedLogBlocks below the highest
+// block, r.mu has to be held.
+func (r *Recorder) forgetLogs() {
+	if r.logsHead < recordedLogBlocks {
+		return
+	}
+	for key, block := range r.logs {
+		if block < r.logsHead-recordedLogBlocks {
+			delete(r.logs, key)
+		}
+	}
+}
+
+// CallContract records the result of the call, failed calls included.
+func (r *Recorder) CallContract(ctx context.Context, msg ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	result, err := r.Backend.CallContract(ctx, msg, blockNumber)
+	if msg.To != nil {
+		recorded := &call{To: *msg.To, Data: msg.Data, Result: result}
+		if err != nil {
+			recorded.Err = err.Error()
+		}
+		r.write(&entry{Kind: entryCall, Call: recorded})
+	}
+	return result, err
+}
+
+// BlockByHash records the block with all of its transactions.
+func (r *Recorder) BlockByHash(ctx context.Context, hash common.Hash) (*types.Block, error) {
+	block, err := r.Backend.BlockByHash(ctx, hash)
+	if err != nil {
+		return nil, err
+	}
+	encoded, err := rlp.EncodeToBytes(block)
+	if err != nil {
+		return nil, fmt.Errorf("failed encoding block %s: %w", hash, err)
+	}
+	r.write(&entry{Kind: entryBlock, Block: encoded})
+	return block, nil
+}
+
+// TransactionByHash records the transaction if the backend can look up
+// transactions.
+func (r *Recorder) TransactionByHash(ctx context.Context, hash common.Hash) (*types.Transaction, bool, error) {
+	txBackend, ok := r.Backend.(monitor.TransactionBackend)
+	if !ok {
+		return nil, false, errNoTransactions
+	}
+	tx, isPending, err := txBackend.TransactionByHash(ctx, hash)
+	if err != nil {
+		return nil, false, err
+	}
+	encoded, err := tx.MarshalBinary()
+	if err != nil {
+		return nil, false, fmt.Errorf("failed encoding transaction %s: %w", hash, err)
+	}
+	r.write(&entry{Kind: entryTx, Tx: &transaction{Data: encoded, Pending: isPending}})
+	return tx, isPending, nil
+}
+
+// TransactionReceipt records the receipt if the backend can look up
+// transactions.
+func (r *Recorder) TransactionReceipt(ctx context.Context, hash common.Hash) (*types.Receipt, error) {
+	txBackend, ok := r.Backend.(monitor.TransactionBackend)
+	if !ok {
+		return nil, errNoTransactions
+	}
+	receipt, err := txBackend.TransactionReceipt(ctx, hash)
+	if err != nil {
+		return nil, err
+	}
+	r.write(&entry{Kind: entryReceipt, Receipt: receipt})
+	return receipt, nil
+}
+
+// SubscribeNewHead records every header delivered to ch.
+func (r *Recorder) SubscribeNewHead(ctx context.Context, ch chan<- *types.Header) (ethereum.Subscription, error) {
+	headers := make(chan *types.Header)
+	sub, err := r.Backend.SubscribeNewHead(ctx, headers)
+	if err != nil {
+		return nil, err
+	}
+	r.recordSubscription(subscriptionHeads, nil)
+	return forward(sub, headers, ch, func(header *types.Header) {
+		r.write(&entry{Kind: entryHeader, Header: header})
+	}), nil
+}
+
+// SubscribeFilterLogs records every log delivered to ch. Logs matching
+// several subscriptions are recorded once.
+func (r *Recorder) SubscribeFilterLogs(ctx context.Context, query ethereum.FilterQuery, ch chan<- types.Log) (ethereum.Subscription, error) {
+	logs := make(chan types.Log)
+	sub, err := r.Backend.SubscribeFilterLogs(ctx, query, logs)
+	if err != nil {
+		return nil, err
+	}
+	r.recordSubscription(subscriptionLogs, &query)
+	return forward(sub, logs, ch, r.recordLog), nil
+}
+
+// SubscribePendingTransactions records every pending transaction hash
+// delivered to ch if the backend can subscribe to them.
+func (r *Recorder) SubscribePendingTransactions(ctx context.Context, ch chan<- common.Hash) (ethereum.Subscription, error) {
+	pendingBackend, ok := r.Backend.(monitor.PendingBackend)
+	if !ok {
+		return nil, errNoPending
+	}
+	hashes := make(chan common.Hash)
+	sub, err := pendingBackend.SubscribePendingTransactions(ctx, hashes)
+	if err != nil {
+		return nil, err
+	}
+	r.recordSubscription(subscriptionPending, nil)
+	return forward(sub, hashes, ch, func(hash common.Hash) {
+		r.write(&entry{Kind: entryPending, PendingHash: &hash})
+	}), nil
+}
+
+// forward passes values from in to out, recording each of them first.
+func forward[T any](sub ethereum.Subscription, in <-chan T, out chan<- T, record func(T)) ethereum.Subscription {
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case value := <-in:
+				record(value)
+				select {
+				case out <- value:
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	})
+}
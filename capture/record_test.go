@@ -0,0 +1,34 @@
+package capture
+
+import (
+	"math/big"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+func TestRecordedLogsBounded(t *testing.T) {
+	recorder, err := NewRecorder(filepath.Join(t.TempDir(), "capture.jsonl.gz"), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer recorder.Close()
+
+	blockLog := func(number uint64) types.Log {
+		return types.Log{BlockNumber: number, BlockHash: common.BigToHash(new(big.Int).SetUint64(number))}
+	}
+	for number := uint64(1); number <= 10*recordedLogBlocks; number++ {
+		recorder.recordLog(blockLog(number))
+	}
+	if len(recorder.logs) > recordedLogBlocks+1 {
+		t.Errorf("expected at most %d recorded logs to be remembered, got %d", recordedLogBlocks+1, len(recorder.logs))
+	}
+	if _, ok := recorder.logs[logKey{block: blockLog(10 * recordedLogBlocks).BlockHash}]; !ok {
+		t.Error("expected the latest log to be remembered")
+	}
+	if _, ok := recorder.logs[logKey{block: blockLog(1).BlockHash}]; ok {
+		t.Error("expected the first log to be forgotten")
+	}
+}
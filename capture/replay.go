@@ -0,0 +1,373 @@
+package capture
+
+import (
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+	"github.com/ethereum/go-ethereum/rlp"
+	"hw-3/logfilter"
+)
+
+// subscribeTimeout is how long playback waits for the monitor to make a
+// subscription the capture contains before going on without it.
+const subscribeTimeout = 10 * time.Second
+
+var errNotRecorded = errors.New("not recorded in capture")
+
+type replaySub struct {
+	key     string
+	query   ethereum.FilterQuery
+	headers chan<- *types.Header
+	logs    chan<- types.Log
+	pending chan<- common.Hash
+	quit    chan struct{}
+}
+
+// Replay is a Backend serving a capture file. Subscriptions receive the
+// recorded headers, logs and pending transaction hashes once Play reaches
+// them, contract calls and
+// transaction lookups return the latest result recorded up to that point.
+type Replay struct {
+	entries  []*entry
+	blocks   map[common.Hash]*types.Block
+	calls    map[string][]*entry
+	txs      map[common.Hash][]*entry
+	receipts map[common.Hash]*types.Receipt
+
+	mu      sync.Mutex
+	offset  int64
+	subs    map[*replaySub]struct{}
+	changed chan struct{}
+}
+
+// Open loads the capture file at path.
+func Open(path string) (*Replay, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed opening capture file %s: %w", path, err)
+	}
+	defer file.Close()
+
+	gzipReader, err := gzip.NewReader(file)
+	if err != nil {
+		return nil, fmt.Errorf("failed decompressing capture file %s: %w", path, err)
+	}
+	defer gzipReader.Close()
+
+	r := &Replay{
+		blocks:   make(map[common.Hash]*types.Block),
+		calls:    make(map[string][]*entry),
+		txs:      make(map[common.Hash][]*entry),
+		receipts: make(map[common.Hash]*types.Receipt),
+		subs:     make(map[*replaySub]struct{}),
+		changed:  make(chan struct{}),
+	}
+	decoder := json.NewDecoder(gzipReader)
+	for {
+		e := &entry{}
+		if err := decoder.Decode(e); err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, fmt.Errorf("failed decoding capture file %s: %w", path, err)
+		}
+		switch e.Kind {
+		case entryBlock:
+			block := new(types.Block)
+			if err := rlp.DecodeBytes(e.Block, block); err != nil {
+				return nil, fmt.Errorf("failed decoding block from capture file %s: %w", path, err)
+			}
+			r.blocks[block.Hash()] = block
+		case entryCall:
+			key := e.Call.key()
+			r.calls[key] = append(r.calls[key], e)
+		case entryTx:
+			tx := new(types.Transaction)
+			if err := tx.UnmarshalBinary(e.Tx.Data); err != nil {
+				return nil, fmt.Errorf("failed decoding transaction from capture file %s: %w", path, err)
+			}
+			r.txs[tx.Hash()] = append(r.txs[tx.Hash()], e)
+		case entryReceipt:
+			r.receipts[e.Receipt.TxHash] = e.Receipt
+		default:
+			r.entries = append(r.entries, e)
+		}
+	}
+	return r, nil
+}
+
+// Play delivers the recorded headers, logs and pending transaction hashes to
+// the subscriptions in the original order. Speed scales the original pace,
+// e.g. 10 replays ten times faster, zero replays without waiting. It returns
+// once the whole capture was delivered or ctx is cancelled.
+func (r *Replay) Play(ctx context.Context, speed float64) error {
+	started := time.Now()
+	for _, e := range r.entries {
+		if speed > 0 {
+			wait := time.Duration(float64(e.Offset)/speed) - time.Since(started)
+			if err := sleep(ctx, wait); err != nil {
+				return err
+			}
+		}
+		r.mu.Lock()
+		r.offset = e.Offset
+		r.mu.Unlock()
+
+		switch e.Kind {
+		case entrySubscribe:
+			if err := r.waitSubscription(ctx, subscriptionKey(e.Subscription, e.Query)); err != nil {
+				return err
+			}
+		case entryHeader:
+			for _, sub := range r.subscribers() {
+				if sub.headers == nil {
+					continue
+				}
+				select {
+				case sub.headers <- types.CopyHeader(e.Header):
+				case <-sub.quit:
+				case <-ctx.Done():
+					return ctx.Err()
+				}
+			}
+		case entryPending:
+			for _, sub := range r.subscribers() {
+				if sub.pending == nil {
+					continue
+				}
+				select {
+				case sub.pending <- *e.PendingHash:
+				case <-sub.quit:
+				case <-ctx.Done():
+					return ctx.Err()
+				}
+			}
+		case entryLog:
+			for _, sub := range r.subscribers() {
+				if sub.logs == nil || !logfilter.Match(sub.query, e.Log) {
+					continue
+				}
+				select {
+				case sub.logs <- *e.Log:
+				case <-sub.quit:
+				case <-ctx.Done():
+					return ctx.Err()
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return nil
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (r *Replay) subscribers() []*replaySub {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	subs := make([]*replaySub, 0, len(r.subs))
+	for sub := range r.subs {
+		subs = append(subs, sub)
+	}
+	return subs
+}
+
+// waitSubscription waits until the monitor subscribes with key, so nothing
+// recorded after the subscription gets lost. Subscriptions the monitor does
+// not make, e.g. because it watches fewer feeds than during the recording,
+// are given up on after subscribeTimeout.
+func (r *Replay) waitSubscription(ctx context.Context, key string) error {
+	timeout := time.NewTimer(subscribeTimeout)
+	defer timeout.Stop()
+	for {
+		r.mu.Lock()
+		changed := r.changed
+		found := false
+		for sub := range r.subs {
+			if sub.key == key {
+				found = true
+				break
+			}
+		}
+		r.mu.Unlock()
+		if found {
+			return nil
+		}
+
+		select {
+		case <-changed:
+		case <-timeout.C:
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+func (r *Replay) subscribe(sub *replaySub) ethereum.Subscription {
+	sub.quit = make(chan struct{})
+	r.mu.Lock()
+	r.subs[sub] = struct{}{}
+	close(r.changed)
+	r.changed = make(chan struct{})
+	r.mu.Unlock()
+
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		<-quit
+		r.mu.Lock()
+		delete(r.subs, sub)
+		r.mu.Unlock()
+		close(sub.quit)
+		return nil
+	})
+}
+
+// SubscribeNewHead delivers the recorded headers to ch.
+func (r *Replay) SubscribeNewHead(ctx context.Context, ch chan<- *types.Header) (ethereum.Subscription, error) {
+	return r.subscribe(&replaySub{key: subscriptionKey(subscriptionHeads, nil), headers: ch}), nil
+}
+
+// SubscribeFilterLogs delivers the recorded logs matching query to ch.
+func (r *Replay) SubscribeFilterLogs(ctx context.Context, query ethereum.FilterQuery, ch chan<- types.Log) (ethereum.Subscription, error) {
+	return r.subscribe(&replaySub{key: subscriptionKey(subscriptionLogs, &query), query: query, logs: ch}), nil
+}
+
+// SubscribePendingTransactions delivers the recorded pending transaction
+// hashes to ch.
+func (r *Replay) SubscribePendingTransactions(ctx context.Context, ch chan<- common.Hash) (ethereum.Subscription, error) {
+	return r.subscribe(&replaySub{key: subscriptionKey(subscriptionPending, nil), pending: ch}), nil
+}
+
+// BlockByHash returns a recorded block.
+func (r *Replay) BlockByHash(ctx context.Context, hash common.Hash) (*types.Block, error) {
+	block, ok := r.blocks[hash]
+	if !ok {
+		return nil, ethereum.NotFound
+	}
+	return block, nil
+}
+
+// CallContract returns the latest result of the same call recorded before
+// the current playback position, or the first one if playback has not
+// reached any yet.
+func (r *Replay) CallContract(ctx context.Context, msg ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	if msg.To == nil {
+		return nil, errNotRecorded
+	}
+	calls := r.calls[(&call{To: *msg.To, Data: msg.Data}).key()]
+	if len(calls) == 0 {
+		return nil, fmt.Errorf("call to %s: %w", msg.To, errNotRecorded)
+	}
+
+	i := r.recordedAt(calls)
+	if calls[i].Call.Err != "" {
+		return nil, errors.New(calls[i].Call.Err)
+	}
+	return calls[i].Call.Result, nil
+}
+
+// recordedAt returns the index of the latest of entries recorded before the
+// current playback position, or of the first one if playback has not reached
+// any yet.
+func (r *Replay) recordedAt(entries []*entry) int {
+	r.mu.Lock()
+	offset := r.offset
+	r.mu.Unlock()
+	i := sort.Search(len(entries), func(i int) bool { return entries[i].Offset > offset })
+	if i > 0 {
+		i--
+	}
+	return i
+}
+
+// TransactionByHash returns the transaction as it was recorded last before
+// the current playback position, pending or mined.
+func (r *Replay) TransactionByHash(ctx context.Context, hash common.Hash) (*types.Transaction, bool, error) {
+	txs := r.txs[hash]
+	if len(txs) == 0 {
+		return nil, false, ethereum.NotFound
+	}
+	recorded := txs[r.recordedAt(txs)].Tx
+	tx := new(types.Transaction)
+	if err := tx.UnmarshalBinary(recorded.Data); err != nil {
+		return nil, false, err
+	}
+	return tx, recorded.Pending, nil
+}
+
+// TransactionReceipt returns a recorded receipt.
+func (r *Replay) TransactionReceipt(ctx context.Context, hash common.Hash) (*types.Receipt, error) {
+	receipt, ok := r.receipts[hash]
+	if !ok {
+		return nil, ethereum.NotFound
+	}
+	return receipt, nil
+}
+
+// CodeAt is not recorded, calls are only made to contracts with code.
+func (r *Replay) CodeAt(ctx context.Context, contract common.Address, blockNumber *big.Int) ([]byte, error) {
+	return nil, errNotRecorded
+}
+
+// HeaderByNumber is not recorded.
+func (r *Replay) HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
+	return nil, errNotRecorded
+}
+
+// PendingCodeAt is not recorded.
+func (r *Replay) PendingCodeAt(ctx context.Context, account common.Address) ([]byte, error) {
+	return nil, errNotRecorded
+}
+
+// PendingNonceAt is not recorded.
+func (r *Replay) PendingNonceAt(ctx context.Context, account common.Address) (uint64, error) {
+	return 0, errNotRecorded
+}
+
+// SuggestGasPrice is not recorded.
+func (r *Replay) SuggestGasPrice(ctx context.Context) (*big.Int, error) {
+	return nil, errNotRecorded
+}
+
+// SuggestGasTipCap is not recorded.
+func (r *Replay) SuggestGasTipCap(ctx context.Context) (*big.Int, error) {
+	return nil, errNotRecorded
+}
+
+// EstimateGas is not recorded.
+func (r *Replay) EstimateGas(ctx context.Context, call ethereum.CallMsg) (uint64, error) {
+	return 0, errNotRecorded
+}
+
+// SendTransaction is not supported, a replay cannot change the past.
+func (r *Replay) SendTransaction(ctx context.Context, tx *types.Transaction) error {
+	return errNotRecorded
+}
+
+// FilterLogs is not recorded.
+func (r *Replay) FilterLogs(ctx context.Context, query ethereum.FilterQuery) ([]types.Log, error) {
+	return nil, errNotRecorded
+}
@@ -0,0 +1,224 @@
+// Package decimal implements exact fixed-point numbers for feed answers.
+//
+// A Decimal is an unscaled integer and the number of its fractional digits,
+// just like a Chainlink answer and the decimals of its feed, so prices are
+// formatted, compared and combined without the rounding of binary floats.
+package decimal
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+var (
+	errSyntax     = errors.New("invalid decimal syntax")
+	errTooPrecise = errors.New("too many fractional digits")
+	errDivByZero  = errors.New("division by zero")
+)
+
+// maxScale is the most fractional digits a Decimal can have.
+const maxScale = 255
+
+// Decimal is the number unscaled * 10^-scale. The zero value is 0, values
+// are immutable and safe to share.
+type Decimal struct {
+	unscaled *big.Int
+	scale    uint8
+}
+
+// New returns unscaled * 10^-scale, e.g. New(answer, decimals) is the price
+// of a feed answer.
+func New(unscaled *big.Int, scale uint8) Decimal {
+	if unscaled == nil {
+		return Decimal{scale: scale}
+	}
+	return Decimal{unscaled: new(big.Int).Set(unscaled), scale: scale}
+}
+
+// FromInt64 returns value * 10^-scale.
+func FromInt64(value int64, scale uint8) Decimal {
+	return Decimal{unscaled: big.NewInt(value), scale: scale}
+}
+
+// Parse parses a decimal number such as "-1500.12345678". The scale of the
+// result is the number of fractional digits in s.
+func Parse(s string) (Decimal, error) {
+	digits := strings.TrimPrefix(s, "-")
+	integer, fraction, _ := strings.Cut(digits, ".")
+	if integer == "" || strings.Trim(integer+fraction, "0123456789") != "" {
+		return Decimal{}, fmt.Errorf("%w: %q", errSyntax, s)
+	}
+	if len(fraction) > maxScale {
+		return Decimal{}, fmt.Errorf("%w: %q", errTooPrecise, s)
+	}
+	unscaled, ok := new(big.Int).SetString(integer+fraction, 10)
+	if !ok {
+		return Decimal{}, fmt.Errorf("%w: %q", errSyntax, s)
+	}
+	if strings.HasPrefix(s, "-") {
+		unscaled.Neg(unscaled)
+	}
+	return Decimal{unscaled: unscaled, scale: uint8(len(fraction))}, nil
+}
+
+// MustParse is like Parse but panics on malformed input.
+func MustParse(s string) Decimal {
+	d, err := Parse(s)
+	if err != nil {
+		panic(err)
+	}
+	return d
+}
+
+func (d Decimal) int() *big.Int {
+	if d.unscaled == nil {
+		return new(big.Int)
+	}
+	return d.unscaled
+}
+
+func pow10(n int) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
+}
+
+// Unscaled returns a copy of the unscaled integer.
+func (d Decimal) Unscaled() *big.Int {
+	return new(big.Int).Set(d.int())
+}
+
+// Scale returns the number of fractional digits.
+func (d Decimal) Scale() uint8 {
+	return d.scale
+}
+
+// Sign returns -1, 0 or 1 depending on the sign of d.
+func (d Decimal) Sign() int {
+	return d.int().Sign()
+}
+
+// Rescale returns d with scale fractional digits, digits that no longer fit
+// are truncated towards zero.
+func (d Decimal) Rescale(scale uint8) Decimal {
+	switch {
+	case scale > d.scale:
+		return Decimal{unscaled: new(big.Int).Mul(d.int(), pow10(int(scale-d.scale))), scale: scale}
+	case scale < d.scale:
+		return Decimal{unscaled: new(big.Int).Quo(d.int(), pow10(int(d.scale-scale))), scale: scale}
+	}
+	return d
+}
+
+// align returns both operands with the larger of their scales.
+func align(x, y Decimal) (Decimal, Decimal) {
+	if x.scale < y.scale {
+		return x.Rescale(y.scale), y
+	}
+	return x, y.Rescale(x.scale)
+}
+
+// Cmp compares d and e regardless of their scales and returns -1, 0 or 1.
+func (d Decimal) Cmp(e Decimal) int {
+	x, y := align(d, e)
+	return x.int().Cmp(y.int())
+}
+
+// Equal reports whether d and e are the same number, e.g. 1.5 and 1.50.
+func (d Decimal) Equal(e Decimal) bool {
+	return d.Cmp(e) == 0
+}
+
+// Add returns d + e with the larger of their scales.
+func (d Decimal) Add(e Decimal) Decimal {
+	x, y := align(d, e)
+	return Decimal{unscaled: new(big.Int).Add(x.int(), y.int()), scale: x.scale}
+}
+
+// Sub returns d - e with the larger of their scales.
+func (d Decimal) Sub(e Decimal) Decimal {
+	x, y := align(d, e)
+	return Decimal{unscaled: new(big.Int).Sub(x.int(), y.int()), scale: x.scale}
+}
+
+// Mul returns d * e rounded to scale fractional digits, e.g. the LINK / USD
+// rate is LINK / ETH multiplied by ETH / USD.
+func (d Decimal) Mul(e Decimal, scale uint8) Decimal {
+	product := new(big.Int).Mul(d.int(), e.int())
+	return round(product, int(d.scale)+int(e.scale), scale)
+}
+
+// Quo returns d / e rounded to scale fractional digits, e.g. the ETH / EUR
+// rate is ETH / USD divided by EUR / USD. It fails if e is zero.
+func (d Decimal) Quo(e Decimal, scale uint8) (Decimal, error) {
+	if e.Sign() == 0 {
+		return Decimal{}, errDivByZero
+	}
+	// The quotient is computed with one extra digit to round it
+	numerator := new(big.Int).Set(d.int())
+	denominator := new(big.Int).Set(e.int())
+	if shift := int(scale) + 1 + int(e.scale) - int(d.scale); shift > 0 {
+		numerator.Mul(numerator, pow10(shift))
+	} else if shift < 0 {
+		denominator.Mul(denominator, pow10(-shift))
+	}
+	return round(new(big.Int).Quo(numerator, denominator), int(scale)+1, scale), nil
+}
+
+// round rounds unscaled * 10^-from half away from zero to scale fractional
+// digits.
+func round(unscaled *big.Int, from int, scale uint8) Decimal {
+	if from <= int(scale) {
+		return Decimal{unscaled: new(big.Int).Mul(unscaled, pow10(int(scale)-from)), scale: scale}
+	}
+	divisor := pow10(from - int(scale))
+	quotient, remainder := new(big.Int).QuoRem(unscaled, divisor, new(big.Int))
+	if remainder.Abs(remainder).Lsh(remainder, 1).Cmp(divisor) >= 0 {
+		if unscaled.Sign() < 0 {
+			quotient.Sub(quotient, big.NewInt(1))
+		} else {
+			quotient.Add(quotient, big.NewInt(1))
+		}
+	}
+	return Decimal{unscaled: quotient, scale: scale}
+}
+
+// Float64 returns the nearest float64, for consumers like metrics that do
+// not need exact values.
+func (d Decimal) Float64() float64 {
+	f, _ := new(big.Rat).SetFrac(d.int(), pow10(int(d.scale))).Float64()
+	return f
+}
+
+// String formats d with exactly its scale of fractional digits, e.g.
+// New(big.NewInt(150012345678), 8) is "1500.12345678".
+func (d Decimal) String() string {
+	digits := new(big.Int).Abs(d.int()).String()
+	sign := ""
+	if d.Sign() < 0 {
+		sign = "-"
+	}
+	if d.scale == 0 {
+		return sign + digits
+	}
+	if len(digits) <= int(d.scale) {
+		digits = strings.Repeat("0", int(d.scale)-len(digits)+1) + digits
+	}
+	point := len(digits) - int(d.scale)
+	return sign + digits[:point] + "." + digits[point:]
+}
+
+// MarshalText formats d like String, so it is encoded as a JSON string.
+func (d Decimal) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// UnmarshalText parses text like Parse.
+func (d *Decimal) UnmarshalText(text []byte) error {
+	parsed, err := Parse(string(text))
+	if err != nil {
+		return err
+	}
+	*d = parsed
+	return nil
+}
@@ -0,0 +1,136 @@
+package decimal
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+)
+
+func TestString(t *testing.T) {
+	for _, tc := range []struct {
+		value    Decimal
+		expected string
+	}{
+		{FromInt64(150012345678, 8), "1500.12345678"},
+		{FromInt64(5, 8), "0.00000005"},
+		{FromInt64(-150, 2), "-1.50"},
+		{FromInt64(42, 0), "42"},
+		{Decimal{scale: 2}, "0.00"},
+	} {
+		if got := tc.value.String(); got != tc.expected {
+			t.Errorf("expected %s, got %s", tc.expected, got)
+		}
+	}
+}
+
+func TestExactLargeAnswer(t *testing.T) {
+	// 2^255 - 1 does not survive a trip through a float with default precision
+	answer := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 255), big.NewInt(1))
+	price := New(answer, 18)
+	expected := "57896044618658097711785492504343953926634992332820282019728.792003956564819967"
+	if got := price.String(); got != expected {
+		t.Errorf("expected %s, got %s", expected, got)
+	}
+	parsed, err := Parse(expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if parsed.Unscaled().Cmp(answer) != 0 || parsed.Scale() != 18 {
+		t.Errorf("expected %s with scale 18, got %s with scale %d", answer, parsed.Unscaled(), parsed.Scale())
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	for _, input := range []string{"", "-", ".5", "1.2.3", "1e8", "+1", "0x10"} {
+		if _, err := Parse(input); err == nil {
+			t.Errorf("expected error parsing %q", input)
+		}
+	}
+}
+
+func TestCompare(t *testing.T) {
+	if !MustParse("1.5").Equal(MustParse("1.500")) {
+		t.Error("expected 1.5 to equal 1.500")
+	}
+	if got := MustParse("-0.01").Cmp(MustParse("0.001")); got != -1 {
+		t.Errorf("expected -0.01 < 0.001, got %d", got)
+	}
+	if got := MustParse("1500.1").Cmp(MustParse("1500.09999999")); got != 1 {
+		t.Errorf("expected 1500.1 > 1500.09999999, got %d", got)
+	}
+}
+
+func TestArithmetic(t *testing.T) {
+	if got := MustParse("1.5").Add(MustParse("0.25")).String(); got != "1.75" {
+		t.Errorf("expected 1.75, got %s", got)
+	}
+	if got := MustParse("1.5").Sub(MustParse("2.25")).String(); got != "-0.75" {
+		t.Errorf("expected -0.75, got %s", got)
+	}
+
+	// LINK / USD from LINK / ETH (18 decimals) and ETH / USD (8 decimals)
+	linkETH := New(big.NewInt(5123456789012345), 18)
+	ethUSD := New(big.NewInt(150012345678), 8)
+	if got := linkETH.Mul(ethUSD, 8).String(); got != "7.68581771" {
+		t.Errorf("expected LINK / USD 7.68581771, got %s", got)
+	}
+
+	// ETH / EUR from ETH / USD and EUR / USD
+	eurUSD := New(big.NewInt(98765432), 8)
+	ethEUR, err := ethUSD.Quo(eurUSD, 8)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := ethEUR.String(); got != "1518.87500151" {
+		t.Errorf("expected ETH / EUR 1518.87500151, got %s", got)
+	}
+	if _, err := ethUSD.Quo(Decimal{}, 8); err == nil {
+		t.Error("expected error dividing by zero")
+	}
+}
+
+func TestRounding(t *testing.T) {
+	for _, tc := range []struct {
+		x, y     string
+		scale    uint8
+		expected string
+	}{
+		{"2", "3", 2, "0.67"},
+		{"-2", "3", 2, "-0.67"},
+		{"1", "8", 2, "0.13"},
+		{"1", "3", 0, "0"},
+	} {
+		got, err := MustParse(tc.x).Quo(MustParse(tc.y), tc.scale)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got.String() != tc.expected {
+			t.Errorf("expected %s / %s = %s, got %s", tc.x, tc.y, tc.expected, got)
+		}
+	}
+	if got := MustParse("1.25").Rescale(1).String(); got != "1.2" {
+		t.Errorf("expected rescaling to truncate to 1.2, got %s", got)
+	}
+}
+
+func TestJSON(t *testing.T) {
+	encoded, err := json.Marshal(struct {
+		Price Decimal `json:"price"`
+	}{FromInt64(150000000000, 8)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(encoded) != `{"price":"1500.00000000"}` {
+		t.Errorf("expected price as string, got %s", encoded)
+	}
+
+	var decoded struct {
+		Price Decimal `json:"price"`
+	}
+	if err := json.Unmarshal(encoded, &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if decoded.Price.Scale() != 8 || !decoded.Price.Equal(MustParse("1500")) {
+		t.Errorf("expected 1500 with 8 decimals, got %s", decoded.Price)
+	}
+}
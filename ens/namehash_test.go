@@ -0,0 +1,24 @@
+package ens
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+func TestNameHash(t *testing.T) {
+	// Test vectors of EIP-137
+	for _, tc := range []struct {
+		name     string
+		expected common.Hash
+	}{
+		{"", common.Hash{}},
+		{"eth", common.HexToHash("0x93cdeb708b7545dc668eb9280176169d1c33cfd8ed6f04690a0bcc88a93fc4ae")},
+		{"foo.eth", common.HexToHash("0xde9b09fd7c5f901e23a3f19fecc54828e9c848539801e86591bd9801b019f84f")},
+		{"Foo.ETH", common.HexToHash("0xde9b09fd7c5f901e23a3f19fecc54828e9c848539801e86591bd9801b019f84f")},
+	} {
+		if got := NameHash(tc.name); got != tc.expected {
+			t.Errorf("expected namehash of %q to be %s, got %s", tc.name, tc.expected, got)
+		}
+	}
+}
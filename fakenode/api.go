@@ -0,0 +1,170 @@
+package fakenode
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/eth/filters"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/rpc"
+	"hw-3/logfilter"
+)
+
+// ethAPI serves the subset of the eth namespace used by ethclient and
+// contract bindings.
+type ethAPI struct {
+	node *Node
+}
+
+type callArgs struct {
+	From  *common.Address `json:"from"`
+	To    *common.Address `json:"to"`
+	Data  hexutil.Bytes   `json:"data"`
+	Input hexutil.Bytes   `json:"input"`
+}
+
+func (api *ethAPI) ChainId(ctx context.Context) (*hexutil.Big, error) {
+	if err := api.node.intercept(ctx); err != nil {
+		return nil, err
+	}
+	return (*hexutil.Big)(params.MainnetChainConfig.ChainID), nil
+}
+
+func (api *ethAPI) BlockNumber(ctx context.Context) (hexutil.Uint64, error) {
+	if err := api.node.intercept(ctx); err != nil {
+		return 0, err
+	}
+	return hexutil.Uint64(api.node.Head().Number.Uint64()), nil
+}
+
+func (api *ethAPI) GetBlockByHash(ctx context.Context, hash common.Hash, fullTx bool) (map[string]interface{}, error) {
+	if err := api.node.intercept(ctx); err != nil {
+		return nil, err
+	}
+	return marshalBlock(api.node.header(hash))
+}
+
+func (api *ethAPI) GetBlockByNumber(ctx context.Context, number rpc.BlockNumber, fullTx bool) (map[string]interface{}, error) {
+	if err := api.node.intercept(ctx); err != nil {
+		return nil, err
+	}
+	return marshalBlock(api.node.headerByNumber(number))
+}
+
+func (api *ethAPI) GetTransactionByHash(ctx context.Context, hash common.Hash) (map[string]interface{}, error) {
+	if err := api.node.intercept(ctx); err != nil {
+		return nil, err
+	}
+	return marshalPendingTransaction(api.node.pendingTransaction(hash))
+}
+
+func (api *ethAPI) Call(ctx context.Context, args callArgs, block *rpc.BlockNumberOrHash) (hexutil.Bytes, error) {
+	if err := api.node.intercept(ctx); err != nil {
+		return nil, err
+	}
+	if args.To == nil {
+		return nil, errExecutionReverted
+	}
+	input := args.Input
+	if input == nil {
+		input = args.Data
+	}
+	return api.node.call(*args.To, input)
+}
+
+func (api *ethAPI) NewHeads(ctx context.Context) (*rpc.Subscription, error) {
+	if err := api.node.intercept(ctx); err != nil {
+		return nil, err
+	}
+	notifier, supported := rpc.NotifierFromContext(ctx)
+	if !supported {
+		return nil, rpc.ErrNotificationsUnsupported
+	}
+
+	rpcSub := notifier.CreateSubscription()
+	headers := make(chan *types.Header, 16)
+	feedSub := api.node.headFeed.Subscribe(headers)
+	api.node.trackSubscription(1)
+	go func() {
+		defer api.node.trackSubscription(-1)
+		defer feedSub.Unsubscribe()
+		for {
+			select {
+			case header := <-headers:
+				notifier.Notify(rpcSub.ID, header)
+			case <-rpcSub.Err():
+				return
+			case <-notifier.Closed():
+				return
+			}
+		}
+	}()
+	return rpcSub, nil
+}
+
+func (api *ethAPI) NewPendingTransactions(ctx context.Context) (*rpc.Subscription, error) {
+	if err := api.node.intercept(ctx); err != nil {
+		return nil, err
+	}
+	notifier, supported := rpc.NotifierFromContext(ctx)
+	if !supported {
+		return nil, rpc.ErrNotificationsUnsupported
+	}
+
+	rpcSub := notifier.CreateSubscription()
+	hashes := make(chan common.Hash, 16)
+	feedSub := api.node.pendingFeed.Subscribe(hashes)
+	api.node.trackSubscription(1)
+	go func() {
+		defer api.node.trackSubscription(-1)
+		defer feedSub.Unsubscribe()
+		for {
+			select {
+			case hash := <-hashes:
+				notifier.Notify(rpcSub.ID, hash)
+			case <-rpcSub.Err():
+				return
+			case <-notifier.Closed():
+				return
+			}
+		}
+	}()
+	return rpcSub, nil
+}
+
+func (api *ethAPI) Logs(ctx context.Context, crit filters.FilterCriteria) (*rpc.Subscription, error) {
+	if err := api.node.intercept(ctx); err != nil {
+		return nil, err
+	}
+	notifier, supported := rpc.NotifierFromContext(ctx)
+	if !supported {
+		return nil, rpc.ErrNotificationsUnsupported
+	}
+
+	rpcSub := notifier.CreateSubscription()
+	batches := make(chan []types.Log, 16)
+	feedSub := api.node.logsFeed.Subscribe(batches)
+	api.node.trackSubscription(1)
+	go func() {
+		defer api.node.trackSubscription(-1)
+		defer feedSub.Unsubscribe()
+		for {
+			select {
+			case logs := <-batches:
+				for i := range logs {
+					if logfilter.Match(ethereum.FilterQuery(crit), &logs[i]) {
+						notifier.Notify(rpcSub.ID, &logs[i])
+					}
+				}
+			case <-rpcSub.Err():
+				return
+			case <-notifier.Closed():
+				return
+			}
+		}
+	}()
+	return rpcSub, nil
+}
@@ -0,0 +1,412 @@
+// Package fakenode is a stand-in Ethereum JSON-RPC node serving HTTP and
+// websocket clients, e.g. ethclient.DialContext, from scripted headers, logs,
+// pending transactions and call results.
+//
+// Unlike the simulated chain it executes nothing, which makes it possible to
+// reproduce what real providers do to long running clients: dropped
+// websocket connections, slow responses, rate limiting, reorgs and logs that
+// do not decode.
+package fakenode
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+const (
+	// RateLimitCode is the JSON-RPC error code of rate limited requests, the
+	// same providers return once the request quota is exhausted.
+	RateLimitCode = 429
+
+	blockTime     = 12
+	blockGasLimit = 30_000_000
+	startBlock    = 15_000_000
+)
+
+var (
+	initialBaseFee = big.NewInt(params.GWei * 10)
+
+	errExecutionReverted = errors.New("execution reverted")
+)
+
+type rateLimitError struct{}
+
+func (rateLimitError) Error() string  { return "rate limit exceeded" }
+func (rateLimitError) ErrorCode() int { return RateLimitCode }
+
+type callKey struct {
+	to       common.Address
+	selector [4]byte
+}
+
+// Node is a running fake node. The zero value is not usable, create nodes
+// with New.
+type Node struct {
+	http *httptest.Server
+
+	mu        sync.Mutex
+	server    *rpc.Server
+	headers   map[common.Hash]*types.Header
+	canonical map[uint64]*types.Header
+	head      *types.Header
+	calls     map[callKey][]byte
+	pending   map[common.Hash]*types.Transaction
+	delay     time.Duration
+	limited   int
+	subs      int
+
+	headFeed    event.Feed
+	logsFeed    event.Feed
+	pendingFeed event.Feed
+}
+
+// New starts a node on a local port whose chain consists of a single block.
+func New() (*Node, error) {
+	genesis := &types.Header{
+		ParentHash:  common.Hash{},
+		UncleHash:   types.EmptyUncleHash,
+		Root:        types.EmptyRootHash,
+		TxHash:      types.EmptyRootHash,
+		ReceiptHash: types.EmptyRootHash,
+		Difficulty:  new(big.Int),
+		Number:      big.NewInt(startBlock),
+		GasLimit:    blockGasLimit,
+		GasUsed:     blockGasLimit / 2,
+		Time:        uint64(time.Now().Unix()),
+		BaseFee:     initialBaseFee,
+	}
+	n := &Node{
+		headers:   map[common.Hash]*types.Header{genesis.Hash(): genesis},
+		canonical: map[uint64]*types.Header{startBlock: genesis},
+		head:      genesis,
+		calls:     make(map[callKey][]byte),
+		pending:   make(map[common.Hash]*types.Transaction),
+	}
+	server, err := n.newServer()
+	if err != nil {
+		return nil, err
+	}
+	n.server = server
+	n.http = httptest.NewServer(n)
+	return n, nil
+}
+
+func (n *Node) newServer() (*rpc.Server, error) {
+	server := rpc.NewServer()
+	if err := server.RegisterName("eth", &ethAPI{node: n}); err != nil {
+		return nil, fmt.Errorf("failed registering eth API: %w", err)
+	}
+	return server, nil
+}
+
+// ServeHTTP answers JSON-RPC requests over HTTP and upgrades websocket
+// connections.
+func (n *Node) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	n.mu.Lock()
+	server := n.server
+	n.mu.Unlock()
+
+	if strings.EqualFold(r.Header.Get("Upgrade"), "websocket") {
+		server.WebsocketHandler([]string{"*"}).ServeHTTP(w, r)
+		return
+	}
+	server.ServeHTTP(w, r)
+}
+
+// URL returns the HTTP endpoint of the node.
+func (n *Node) URL() string {
+	return n.http.URL
+}
+
+// WebsocketURL returns the websocket endpoint of the node, required for
+// subscriptions.
+func (n *Node) WebsocketURL() string {
+	return "ws" + strings.TrimPrefix(n.http.URL, "http")
+}
+
+// Close drops all connections and stops the node.
+func (n *Node) Close() {
+	n.mu.Lock()
+	server := n.server
+	n.mu.Unlock()
+
+	server.Stop()
+	n.http.Close()
+}
+
+// Head returns the header of the latest block.
+func (n *Node) Head() *types.Header {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	return types.CopyHeader(n.head)
+}
+
+// Subscriptions returns the number of active subscriptions, so tests can wait
+// for clients to resubscribe.
+func (n *Node) Subscriptions() int {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	return n.subs
+}
+
+// SetCall makes every eth_call to the given address whose input starts with
+// selector return output, regardless of the remaining arguments.
+func (n *Node) SetCall(to common.Address, selector []byte, output []byte) {
+	key := callKey{to: to}
+	copy(key.selector[:], selector)
+
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.calls[key] = common.CopyBytes(output)
+}
+
+// SetCallResult is SetCall for the method of contractABI, outputs are
+// packed according to the method definition.
+func (n *Node) SetCallResult(to common.Address, contractABI abi.ABI, method string, outputs ...interface{}) error {
+	abiMethod, ok := contractABI.Methods[method]
+	if !ok {
+		return fmt.Errorf("no method %s in ABI", method)
+	}
+	output, err := abiMethod.Outputs.Pack(outputs...)
+	if err != nil {
+		return fmt.Errorf("failed packing outputs of %s: %w", method, err)
+	}
+	n.SetCall(to, abiMethod.ID, output)
+	return nil
+}
+
+// Mine appends an empty block to the head of the chain and notifies
+// subscribers.
+func (n *Node) Mine() *types.Header {
+	return n.PushHeader(n.child(n.Head(), nil))
+}
+
+// PushHeader makes header the new head of the chain and notifies
+// subscribers. The header does not have to extend the previous head.
+func (n *Node) PushHeader(header *types.Header) *types.Header {
+	header = types.CopyHeader(header)
+
+	n.mu.Lock()
+	n.headers[header.Hash()] = header
+	n.canonical[header.Number.Uint64()] = header
+	n.head = header
+	n.mu.Unlock()
+
+	n.headFeed.Send(types.CopyHeader(header))
+	return header
+}
+
+// PushLogs sends logs to all subscribers whose filter they match. The logs
+// are sent as they are, so they can be made malformed on purpose.
+func (n *Node) PushLogs(logs ...types.Log) {
+	n.logsFeed.Send(logs)
+}
+
+// PushPendingTransaction adds tx to the mempool and sends its hash to all
+// pending transaction subscribers. The transaction stays pending, it is
+// never included in a block.
+func (n *Node) PushPendingTransaction(tx *types.Transaction) {
+	n.mu.Lock()
+	n.pending[tx.Hash()] = tx
+	n.mu.Unlock()
+
+	n.pendingFeed.Send(tx.Hash())
+}
+
+// Reorg replaces the head block with a sibling. The logs of the replaced
+// block are sent again with Removed set, as nodes do on reorgs.
+func (n *Node) Reorg(removed ...types.Log) *types.Header {
+	head := n.Head()
+
+	n.mu.Lock()
+	parent := n.headers[head.ParentHash]
+	n.mu.Unlock()
+	if parent == nil {
+		parent = &types.Header{Number: new(big.Int).Sub(head.Number, common.Big1), Time: head.Time - blockTime}
+	}
+
+	if len(removed) > 0 {
+		reverted := make([]types.Log, len(removed))
+		for i, vLog := range removed {
+			reverted[i] = vLog
+			reverted[i].Removed = true
+		}
+		n.PushLogs(reverted...)
+	}
+	return n.PushHeader(n.child(parent, []byte("reorg")))
+}
+
+// DropSubscriptions closes all websocket connections, which fails all
+// subscriptions of the connected clients. Clients may reconnect right away.
+func (n *Node) DropSubscriptions() error {
+	server, err := n.newServer()
+	if err != nil {
+		return err
+	}
+	n.mu.Lock()
+	dropped := n.server
+	n.server = server
+	n.mu.Unlock()
+
+	dropped.Stop()
+	return nil
+}
+
+// SetDelay delays every following response by d.
+func (n *Node) SetDelay(d time.Duration) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.delay = d
+}
+
+// RateLimit makes the next count requests, including subscription requests,
+// fail with RateLimitCode.
+func (n *Node) RateLimit(count int) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.limited = count
+}
+
+func (n *Node) child(parent *types.Header, extra []byte) *types.Header {
+	baseFee := initialBaseFee
+	if parent.BaseFee != nil {
+		baseFee = parent.BaseFee
+	}
+	return &types.Header{
+		ParentHash:  parent.Hash(),
+		UncleHash:   types.EmptyUncleHash,
+		Root:        types.EmptyRootHash,
+		TxHash:      types.EmptyRootHash,
+		ReceiptHash: types.EmptyRootHash,
+		Difficulty:  new(big.Int),
+		Number:      new(big.Int).Add(parent.Number, common.Big1),
+		GasLimit:    blockGasLimit,
+		GasUsed:     blockGasLimit / 2,
+		Time:        parent.Time + blockTime,
+		Extra:       extra,
+		BaseFee:     new(big.Int).Set(baseFee),
+	}
+}
+
+// intercept applies the configured faults to a request.
+func (n *Node) intercept(ctx context.Context) error {
+	n.mu.Lock()
+	delay := n.delay
+	limited := n.limited > 0
+	if limited {
+		n.limited--
+	}
+	n.mu.Unlock()
+
+	if limited {
+		return rateLimitError{}
+	}
+	if delay > 0 {
+		timer := time.NewTimer(delay)
+		defer timer.Stop()
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	return nil
+}
+
+func (n *Node) header(hash common.Hash) *types.Header {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	return n.headers[hash]
+}
+
+func (n *Node) headerByNumber(number rpc.BlockNumber) *types.Header {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if number < 0 {
+		return n.head
+	}
+	return n.canonical[uint64(number)]
+}
+
+func (n *Node) pendingTransaction(hash common.Hash) *types.Transaction {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	return n.pending[hash]
+}
+
+func (n *Node) call(to common.Address, input []byte) ([]byte, error) {
+	key := callKey{to: to}
+	copy(key.selector[:], input)
+
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	output, ok := n.calls[key]
+	if !ok {
+		return nil, errExecutionReverted
+	}
+	return output, nil
+}
+
+func (n *Node) trackSubscription(delta int) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.subs += delta
+}
+
+// marshalBlock encodes header as a block without transactions.
+func marshalBlock(header *types.Header) (map[string]interface{}, error) {
+	if header == nil {
+		return nil, nil
+	}
+	encoded, err := json.Marshal(header)
+	if err != nil {
+		return nil, err
+	}
+	block := make(map[string]interface{})
+	if err := json.Unmarshal(encoded, &block); err != nil {
+		return nil, err
+	}
+	block["transactions"] = []interface{}{}
+	block["uncles"] = []interface{}{}
+	return block, nil
+}
+
+// marshalPendingTransaction encodes tx as a transaction not included in a
+// block yet.
+func marshalPendingTransaction(tx *types.Transaction) (map[string]interface{}, error) {
+	if tx == nil {
+		return nil, nil
+	}
+	from, err := types.Sender(types.LatestSignerForChainID(tx.ChainId()), tx)
+	if err != nil {
+		return nil, err
+	}
+	encoded, err := json.Marshal(tx)
+	if err != nil {
+		return nil, err
+	}
+	pending := make(map[string]interface{})
+	if err := json.Unmarshal(encoded, &pending); err != nil {
+		return nil, err
+	}
+	pending["from"] = from
+	pending["blockHash"] = nil
+	pending["blockNumber"] = nil
+	pending["transactionIndex"] = nil
+	return pending, nil
+}
@@ -0,0 +1,244 @@
+package fakenode
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/ethclient/gethclient"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+const testTimeout = 5 * time.Second
+
+func dial(t *testing.T, url string) (*Node, *ethclient.Client) {
+	t.Helper()
+	node, err := New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(node.Close)
+	if url == "" {
+		url = node.WebsocketURL()
+	}
+	client, err := ethclient.Dial(url)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(client.Close)
+	return node, client
+}
+
+func TestHeaders(t *testing.T) {
+	node, client := dial(t, "")
+
+	headers := make(chan *types.Header)
+	sub, err := client.SubscribeNewHead(context.Background(), headers)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer sub.Unsubscribe()
+
+	mined := node.Mine()
+	select {
+	case header := <-headers:
+		if header.Hash() != mined.Hash() {
+			t.Errorf("expected header %s, got %s", mined.Hash(), header.Hash())
+		}
+	case <-time.After(testTimeout):
+		t.Fatal("timed out waiting for header")
+	}
+
+	block, err := client.BlockByHash(context.Background(), mined.Hash())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if block.NumberU64() != mined.Number.Uint64() {
+		t.Errorf("expected block %d, got %d", mined.Number, block.NumberU64())
+	}
+	if _, err := client.BlockByHash(context.Background(), common.Hash{1}); !errors.Is(err, ethereum.NotFound) {
+		t.Errorf("expected not found, got %v", err)
+	}
+}
+
+func TestLogFilter(t *testing.T) {
+	node, client := dial(t, "")
+
+	address := common.Address{1}
+	topic := common.Hash{2}
+	logs := make(chan types.Log)
+	sub, err := client.SubscribeFilterLogs(context.Background(), ethereum.FilterQuery{
+		Addresses: []common.Address{address},
+		Topics:    [][]common.Hash{{topic}},
+	}, logs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer sub.Unsubscribe()
+
+	node.PushLogs(
+		types.Log{Address: common.Address{3}, Topics: []common.Hash{topic}},
+		types.Log{Address: address},
+		types.Log{Address: address, Topics: []common.Hash{topic}, Data: []byte{4}},
+	)
+	select {
+	case vLog := <-logs:
+		if len(vLog.Data) != 1 || vLog.Data[0] != 4 {
+			t.Errorf("unexpected log %+v", vLog)
+		}
+	case <-time.After(testTimeout):
+		t.Fatal("timed out waiting for log")
+	}
+}
+
+func TestCallsAndRateLimit(t *testing.T) {
+	node, client := dial(t, "")
+	httpClient, err := ethclient.Dial(node.URL())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer httpClient.Close()
+
+	to := common.Address{1}
+	node.SetCall(to, []byte{1, 2, 3, 4}, []byte{5})
+	msg := ethereum.CallMsg{To: &to, Data: []byte{1, 2, 3, 4, 6}}
+	output, err := httpClient.CallContract(context.Background(), msg, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(output) != 1 || output[0] != 5 {
+		t.Errorf("unexpected output %x", output)
+	}
+
+	node.RateLimit(1)
+	_, err = client.CallContract(context.Background(), msg, nil)
+	var rpcErr rpc.Error
+	if !errors.As(err, &rpcErr) || rpcErr.ErrorCode() != RateLimitCode {
+		t.Errorf("expected rate limit error, got %v", err)
+	}
+	if _, err := client.CallContract(context.Background(), msg, nil); err != nil {
+		t.Errorf("expected call to succeed after the limit, got %v", err)
+	}
+}
+
+func TestDelay(t *testing.T) {
+	node, client := dial(t, "")
+
+	node.SetDelay(time.Second)
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if _, err := client.BlockNumber(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected deadline exceeded, got %v", err)
+	}
+}
+
+func TestDropSubscriptions(t *testing.T) {
+	node, client := dial(t, "")
+
+	headers := make(chan *types.Header)
+	sub, err := client.SubscribeNewHead(context.Background(), headers)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer sub.Unsubscribe()
+
+	if err := node.DropSubscriptions(); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case err := <-sub.Err():
+		if err == nil {
+			t.Error("expected subscription error")
+		}
+	case <-time.After(testTimeout):
+		t.Fatal("subscription was not dropped")
+	}
+}
+
+func TestReorg(t *testing.T) {
+	node, client := dial(t, "")
+
+	logs := make(chan types.Log)
+	sub, err := client.SubscribeFilterLogs(context.Background(), ethereum.FilterQuery{}, logs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer sub.Unsubscribe()
+
+	replaced := node.Mine()
+	vLog := types.Log{Address: common.Address{1}, Topics: []common.Hash{{2}}, BlockHash: replaced.Hash()}
+	sibling := node.Reorg(vLog)
+	if sibling.Number.Cmp(replaced.Number) != 0 || sibling.Hash() == replaced.Hash() {
+		t.Errorf("expected a sibling of block %d, got %d", replaced.Number, sibling.Number)
+	}
+
+	select {
+	case removed := <-logs:
+		if !removed.Removed || removed.BlockHash != replaced.Hash() {
+			t.Errorf("expected removed log of %s, got %+v", replaced.Hash(), removed)
+		}
+	case <-time.After(testTimeout):
+		t.Fatal("timed out waiting for removed log")
+	}
+}
+
+func TestPendingTransactions(t *testing.T) {
+	node, client := dial(t, "")
+	rpcClient, err := rpc.Dial(node.WebsocketURL())
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(rpcClient.Close)
+
+	hashes := make(chan common.Hash)
+	sub, err := gethclient.New(rpcClient).SubscribePendingTransactions(context.Background(), hashes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer sub.Unsubscribe()
+
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	to := common.Address{1}
+	tx, err := types.SignNewTx(key, types.LatestSignerForChainID(big.NewInt(1)), &types.DynamicFeeTx{
+		ChainID:   big.NewInt(1),
+		To:        &to,
+		Gas:       21000,
+		GasFeeCap: big.NewInt(params.GWei * 20),
+		GasTipCap: big.NewInt(params.GWei),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	node.PushPendingTransaction(tx)
+
+	select {
+	case hash := <-hashes:
+		if hash != tx.Hash() {
+			t.Errorf("expected pending transaction %s, got %s", tx.Hash(), hash)
+		}
+	case <-time.After(testTimeout):
+		t.Fatal("timed out waiting for pending transaction")
+	}
+
+	pending, isPending, err := client.TransactionByHash(context.Background(), tx.Hash())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !isPending || pending.Hash() != tx.Hash() {
+		t.Errorf("expected pending transaction %s, got %s (pending %t)", tx.Hash(), pending.Hash(), isPending)
+	}
+	if _, _, err := client.TransactionByHash(context.Background(), common.Hash{1}); !errors.Is(err, ethereum.NotFound) {
+		t.Errorf("expected not found, got %v", err)
+	}
+}
@@ -0,0 +1,234 @@
+// Package grpcapi serves the prices and blocks observed by the monitor over
+// the gRPC PriceService defined in proto/monitor/v1/monitor.proto.
+package grpcapi
+
+import (
+	"context"
+	"math/big"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"hw-3/monitor"
+	"hw-3/monitorpb"
+	"hw-3/stream"
+)
+
+// executionReverted is how nodes report calls that reverted, with or without
+// a reason appended.
+const executionReverted = "execution reverted"
+
+// Server implements monitorpb.PriceServiceServer. Latest prices come from
+// the monitor, past rounds are queried from the feed proxies and watched
+// events are taken from the hub every monitor event is sent to.
+type Server struct {
+	monitorpb.UnimplementedPriceServiceServer
+
+	mon *monitor.Monitor
+	hub *stream.Hub
+}
+
+// NewServer creates a Server for the given monitor and hub.
+func NewServer(mon *monitor.Monitor, hub *stream.Hub) *Server {
+	return &Server{mon: mon, hub: hub}
+}
+
+// Register registers the PriceService with server.
+func (s *Server) Register(server *grpc.Server) {
+	monitorpb.RegisterPriceServiceServer(server, s)
+}
+
+// findFeed looks a feed up by its tokens, ENS name or proxy address.
+func (s *Server) findFeed(name string) (monitor.Feed, error) {
+	for _, feed := range s.mon.Feeds() {
+		if feed.Tokens == name || (feed.Name != "" && strings.EqualFold(feed.Name, name)) ||
+			strings.EqualFold(feed.Address.Hex(), name) {
+			return feed, nil
+		}
+	}
+	return monitor.Feed{}, status.Errorf(codes.NotFound, "unknown feed %q", name)
+}
+
+func feedMessage(feed monitor.Feed) *monitorpb.Feed {
+	return &monitorpb.Feed{Tokens: feed.Tokens, Address: feed.Address.Hex()}
+}
+
+func timestamp(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
+}
+
+func priceMessage(update *monitor.PriceUpdate) *monitorpb.Price {
+	return &monitorpb.Price{
+		Feed:              feedMessage(update.Feed),
+		Aggregator:        update.Aggregator.Hex(),
+		RoundId:           monitor.ProxyRoundID(update.PhaseID, update.RoundID).String(),
+		Answer:            update.Answer.String(),
+		Decimals:          uint32(update.Decimals),
+		Price:             update.Price.String(),
+		UpdatedAt:         timestamp(update.UpdatedAt),
+		BlockNumber:       update.Log.BlockNumber,
+		TxHash:            update.Log.TxHash.Hex(),
+		Unreliable:        update.Unreliable,
+		AggregatorRoundId: update.RoundID.String(),
+	}
+}
+
+func roundMessage(round *monitor.Round) *monitorpb.Round {
+	return &monitorpb.Round{
+		Feed:            feedMessage(round.Feed),
+		RoundId:         round.RoundID.String(),
+		Answer:          round.Answer.String(),
+		Decimals:        uint32(round.Decimals),
+		Price:           round.Price.String(),
+		StartedAt:       timestamp(round.StartedAt),
+		UpdatedAt:       timestamp(round.UpdatedAt),
+		AnsweredInRound: round.AnsweredInRound.String(),
+	}
+}
+
+func blockMessage(block *monitor.NewBlock) *monitorpb.Block {
+	msg := &monitorpb.Block{
+		Number:       block.Number,
+		Hash:         block.Hash.Hex(),
+		Time:         timestamp(block.Time),
+		Transactions: uint32(block.Transactions),
+	}
+	if block.BaseFee != nil {
+		msg.BaseFee = block.BaseFee.String()
+	}
+	return msg
+}
+
+// ListFeeds returns the monitored feeds.
+func (s *Server) ListFeeds(ctx context.Context, req *monitorpb.ListFeedsRequest) (*monitorpb.ListFeedsResponse, error) {
+	resp := &monitorpb.ListFeedsResponse{}
+	for _, feed := range s.mon.Feeds() {
+		resp.Feeds = append(resp.Feeds, feedMessage(feed))
+	}
+	return resp, nil
+}
+
+// GetLatest returns the last price update the monitor observed. Until the
+// first one arrives the latest round is queried from the proxy instead.
+func (s *Server) GetLatest(ctx context.Context, req *monitorpb.GetLatestRequest) (*monitorpb.Price, error) {
+	feed, err := s.findFeed(req.Feed)
+	if err != nil {
+		return nil, err
+	}
+	if update := s.mon.LatestPrice(feed); update != nil {
+		return priceMessage(update), nil
+	}
+
+	round, err := s.mon.Round(ctx, feed, nil)
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "failed querying latest round: %s", err)
+	}
+	return &monitorpb.Price{
+		Feed:      feedMessage(feed),
+		RoundId:   round.RoundID.String(),
+		Answer:    round.Answer.String(),
+		Decimals:  uint32(round.Decimals),
+		Price:     round.Price.String(),
+		UpdatedAt: timestamp(round.UpdatedAt),
+	}, nil
+}
+
+// GetRound queries a round from the feed proxy.
+func (s *Server) GetRound(ctx context.Context, req *monitorpb.GetRoundRequest) (*monitorpb.Round, error) {
+	feed, err := s.findFeed(req.Feed)
+	if err != nil {
+		return nil, err
+	}
+	roundID, ok := new(big.Int).SetString(req.RoundId, 10)
+	if !ok || roundID.Sign() < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid round id %q", req.RoundId)
+	}
+	round, err := s.mon.Round(ctx, feed, roundID)
+	// Aggregators revert for rounds they have no data for
+	if err != nil && strings.Contains(err.Error(), executionReverted) {
+		return nil, status.Errorf(codes.NotFound, "round %s not found: %s", roundID, err)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "failed querying round %s: %s", roundID, err)
+	}
+	return roundMessage(round), nil
+}
+
+// WatchPrices streams the price updates of the requested feeds. Feeds are
+// looked up again for every update, so feeds given by an ENS name are
+// followed to the proxy the name currently resolves to.
+func (s *Server) WatchPrices(req *monitorpb.WatchPricesRequest, srv monitorpb.PriceService_WatchPricesServer) error {
+	filter := stream.Filter{}
+	for _, name := range req.Feeds {
+		feed, err := s.findFeed(name)
+		if err != nil {
+			return err
+		}
+		// Tokens stay the same when the proxy of a feed changes, the updates are matched exactly below
+		filter.Feeds = append(filter.Feeds, feed.Tokens)
+	}
+
+	return s.watch(srv, filter, func(ev monitor.Event) error {
+		update, ok := ev.(*monitor.PriceUpdate)
+		if !ok || !s.requested(req.Feeds, update.Feed) {
+			return nil
+		}
+		return srv.Send(priceMessage(update))
+	})
+}
+
+// requested reports whether feed is one of the feeds names currently refer
+// to, or names is empty.
+func (s *Server) requested(names []string, feed monitor.Feed) bool {
+	if len(names) == 0 {
+		return true
+	}
+	for _, name := range names {
+		if requested, err := s.findFeed(name); err == nil && requested == feed {
+			return true
+		}
+	}
+	return false
+}
+
+// WatchBlocks streams new blocks.
+func (s *Server) WatchBlocks(req *monitorpb.WatchBlocksRequest, srv monitorpb.PriceService_WatchBlocksServer) error {
+	return s.watch(srv, stream.Filter{Blocks: true}, func(ev monitor.Event) error {
+		block, ok := ev.(*monitor.NewBlock)
+		if !ok {
+			return nil
+		}
+		return srv.Send(blockMessage(block))
+	})
+}
+
+// watch passes the events of the records matching filter to send until the
+// client goes away or the subscription is dropped by the hub.
+func (s *Server) watch(srv grpc.ServerStream, filter stream.Filter, send func(monitor.Event) error) error {
+	sub := s.hub.Subscribe(filter)
+	defer sub.Unsubscribe()
+	// Clients receiving the headers know that nothing sent from now on is missed
+	if err := srv.SendHeader(metadata.MD{}); err != nil {
+		return err
+	}
+	ctx := srv.Context()
+	for {
+		rec, ok := sub.Next(ctx)
+		if !ok {
+			if ctx.Err() != nil {
+				return status.FromContextError(ctx.Err()).Err()
+			}
+			return status.Error(codes.Unavailable, "stream closed or fell behind")
+		}
+		if err := send(rec.Event); err != nil {
+			return err
+		}
+	}
+}
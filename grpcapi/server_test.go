@@ -0,0 +1,316 @@
+package grpcapi
+
+import (
+	"context"
+	"math/big"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"hw-3/aggregator"
+	"hw-3/ens"
+	"hw-3/fakenode"
+	"hw-3/monitor"
+	"hw-3/monitorpb"
+	"hw-3/proxy"
+	"hw-3/simchain"
+	"hw-3/sink"
+	"hw-3/stream"
+)
+
+const (
+	eventTimeout = 10 * time.Second
+	feedTokens   = "ETH / USD"
+)
+
+// startService serves a monitor of a single feed on a simulated chain.
+func startService(t *testing.T) (*simchain.Chain, *simchain.Feed, monitorpb.PriceServiceClient) {
+	t.Helper()
+	chain, err := simchain.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { chain.Close() })
+	feed, err := chain.DeployFeed(8, big.NewInt(150012345678))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	config := monitor.Config{
+		Feeds:        []monitor.Feed{{Tokens: feedTokens, Address: feed.Address}},
+		PollInterval: 50 * time.Millisecond,
+	}
+	_, client := serve(t, monitor.New(config, chain.Backend))
+	return chain, feed, client
+}
+
+// serve runs mon until it subscribed to blocks and a single feed, sends its
+// events to a hub like main does and serves both over an in-memory
+// connection.
+func serve(t *testing.T, mon *monitor.Monitor) (*stream.Hub, monitorpb.PriceServiceClient) {
+	t.Helper()
+	hub := stream.NewHub(16)
+	ctx, cancel := context.WithCancel(context.Background())
+	go mon.Run(ctx)
+	t.Cleanup(func() {
+		cancel()
+		for range mon.Events() {
+		}
+	})
+
+	timeout := time.After(eventTimeout)
+	for pending := 3; pending > 0; {
+		select {
+		case ev := <-mon.Events():
+			if _, ok := ev.(*monitor.Subscribed); ok {
+				pending--
+			}
+			hub.Send(sink.NewRecord(time.Now(), "", ev))
+		case <-timeout:
+			t.Fatal("timed out waiting for subscriptions")
+		}
+	}
+	go func() {
+		for ev := range mon.Events() {
+			hub.Send(sink.NewRecord(time.Now(), "", ev))
+		}
+	}()
+
+	listener := bufconn.Listen(1 << 20)
+	server := grpc.NewServer()
+	NewServer(mon, hub).Register(server)
+	go server.Serve(listener)
+	t.Cleanup(func() {
+		hub.Close()
+		server.Stop()
+	})
+
+	conn, err := grpc.Dial("bufconn",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return listener.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return hub, monitorpb.NewPriceServiceClient(conn)
+}
+
+func testContext(t *testing.T) context.Context {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), eventTimeout)
+	t.Cleanup(cancel)
+	return ctx
+}
+
+func TestQueries(t *testing.T) {
+	_, feed, client := startService(t)
+	ctx := testContext(t)
+
+	feeds, err := client.ListFeeds(ctx, &monitorpb.ListFeedsRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(feeds.Feeds) != 1 || feeds.Feeds[0].Tokens != feedTokens || feeds.Feeds[0].Address != feed.Address.Hex() {
+		t.Errorf("expected feed %s at %s, got %v", feedTokens, feed.Address, feeds.Feeds)
+	}
+
+	latest, err := client.GetLatest(ctx, &monitorpb.GetLatestRequest{Feed: feedTokens})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if latest.Price != "1500.12345678" || latest.Decimals != 8 {
+		t.Errorf("expected price 1500.12345678 with 8 decimals, got %s with %d", latest.Price, latest.Decimals)
+	}
+
+	round, err := client.GetRound(ctx, &monitorpb.GetRoundRequest{Feed: feed.Address.Hex(), RoundId: latest.RoundId})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if round.Answer != "150012345678" || round.StartedAt == nil {
+		t.Errorf("expected answer 150012345678 with start time, got %s started at %v", round.Answer, round.StartedAt)
+	}
+
+	_, err = client.GetLatest(ctx, &monitorpb.GetLatestRequest{Feed: "BTC / USD"})
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("expected NotFound for an unknown feed, got %v", err)
+	}
+	_, err = client.GetRound(ctx, &monitorpb.GetRoundRequest{Feed: feedTokens, RoundId: "12345"})
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("expected NotFound for an unknown round, got %v", err)
+	}
+	_, err = client.GetRound(ctx, &monitorpb.GetRoundRequest{Feed: feedTokens, RoundId: "first"})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("expected InvalidArgument for a malformed round id, got %v", err)
+	}
+}
+
+func TestWatchPrices(t *testing.T) {
+	chain, feed, client := startService(t)
+	ctx := testContext(t)
+
+	prices, err := client.WatchPrices(ctx, &monitorpb.WatchPricesRequest{Feeds: []string{feedTokens}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := prices.Header(); err != nil {
+		t.Fatal(err)
+	}
+	if err := chain.PushAnswer(feed.Aggregator, big.NewInt(151000000000)); err != nil {
+		t.Fatal(err)
+	}
+	price, err := prices.Recv()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if price.Price != "1510.00000000" || price.Aggregator != feed.Aggregator.Address.Hex() || price.BlockNumber == 0 {
+		t.Errorf("expected observed price 1510.00000000, got %v", price)
+	}
+
+	latest, err := client.GetLatest(ctx, &monitorpb.GetLatestRequest{Feed: feedTokens})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if latest.Price != price.Price || latest.TxHash != price.TxHash {
+		t.Errorf("expected latest price to be the watched one, got %v", latest)
+	}
+
+	// Observed prices report the proxy round id like queried ones
+	if price.AggregatorRoundId != "2" || latest.RoundId != price.RoundId {
+		t.Errorf("expected aggregator round 2 in both, got watched %v and latest %v", price, latest)
+	}
+	round, err := client.GetRound(ctx, &monitorpb.GetRoundRequest{Feed: feedTokens, RoundId: price.RoundId})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if round.Answer != price.Answer || round.RoundId != price.RoundId {
+		t.Errorf("expected round %s with answer %s, got %v", price.RoundId, price.Answer, round)
+	}
+}
+
+func TestWatchBlocks(t *testing.T) {
+	chain, _, client := startService(t)
+	ctx := testContext(t)
+
+	blocks, err := client.WatchBlocks(ctx, &monitorpb.WatchBlocksRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := blocks.Header(); err != nil {
+		t.Fatal(err)
+	}
+	hash := chain.Commit()
+	block, err := blocks.Recv()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if block.Hash != hash.Hex() || block.BaseFee == "" {
+		t.Errorf("expected block %s with base fee, got %v", hash, block)
+	}
+}
+
+func TestWatchPricesFollowsName(t *testing.T) {
+	node, err := fakenode.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(node.Close)
+	proxyABI := parseABI(t, proxy.ProxyMetaData.ABI)
+	aggABI := parseABI(t, aggregator.AggregatorMetaData.ABI)
+	ensABI := parseABI(t, ens.ENSMetaData.ABI)
+	resolverABI := parseABI(t, ens.ResolverMetaData.ABI)
+	// Both proxies use the same aggregator, the name resolves to first
+	first := common.HexToAddress("0x5f4eC3Df9cbd43714FE2740f5E3616155c5b8419")
+	second := common.HexToAddress("0xF9680D99D6C9589e2a93a78A04A279e509205945")
+	aggregatorAddress := common.HexToAddress("0x37bC7498f4FF12C19678ee8fE19d713b87F6a9e6")
+	resolverAddress := common.HexToAddress("0x4976fb03C32e5B8cfe2b6cCB31c09Ba78EBaBa41")
+	results := []error{
+		node.SetCallResult(aggregatorAddress, aggABI, "decimals", uint8(8)),
+		node.SetCallResult(ens.RegistryAddress, ensABI, "resolver", resolverAddress),
+		node.SetCallResult(resolverAddress, resolverABI, "addr", first),
+	}
+	for _, address := range []common.Address{first, second} {
+		results = append(results,
+			node.SetCallResult(address, proxyABI, "aggregator", aggregatorAddress),
+			node.SetCallResult(address, proxyABI, "proposedAggregator", common.Address{}),
+			node.SetCallResult(address, proxyABI, "phaseId", uint16(1)))
+	}
+	for _, err := range results {
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	backend, err := ethclient.Dial(node.WebsocketURL())
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(backend.Close)
+
+	config := monitor.Config{
+		Feeds:        []monitor.Feed{{Tokens: feedTokens, Name: "eth-usd.data.eth"}},
+		PollInterval: 50 * time.Millisecond,
+	}
+	hub, client := serve(t, monitor.New(config, backend))
+	ctx := testContext(t)
+	prices, err := client.WatchPrices(ctx, &monitorpb.WatchPricesRequest{Feeds: []string{"eth-usd.data.eth"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := prices.Header(); err != nil {
+		t.Fatal(err)
+	}
+
+	// Wait until the price updates of the proxy the name resolves to next are subscribed to
+	records := hub.Subscribe(stream.Filter{})
+	defer records.Unsubscribe()
+	if err := node.SetCallResult(resolverAddress, resolverABI, "addr", second); err != nil {
+		t.Fatal(err)
+	}
+	for {
+		rec, ok := records.Next(ctx)
+		if !ok {
+			t.Fatal("timed out waiting for the price subscription of the next proxy")
+		}
+		if rec.Type == "subscribed" && rec.Fields["kind"] == string(monitor.SubscriptionPrice) && rec.Address == second.Hex() {
+			break
+		}
+	}
+
+	block := node.Mine()
+	node.PushLogs(types.Log{
+		Address: aggregatorAddress,
+		Topics: []common.Hash{
+			aggABI.Events["AnswerUpdated"].ID,
+			common.BigToHash(big.NewInt(151000000000)),
+			common.BigToHash(big.NewInt(2)),
+		},
+		Data:        common.BigToHash(new(big.Int).SetUint64(block.Time)).Bytes(),
+		BlockNumber: block.Number.Uint64(),
+		BlockHash:   block.Hash(),
+	})
+	price, err := prices.Recv()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if price.Feed.Address != second.Hex() || price.Price != "1510.00000000" {
+		t.Errorf("expected price 1510.00000000 of %s, got %v", second, price)
+	}
+}
+
+func parseABI(t *testing.T, abiJSON string) abi.ABI {
+	t.Helper()
+	parsed, err := abi.JSON(strings.NewReader(abiJSON))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return parsed
+}
@@ -0,0 +1,228 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"hw-3/decimal"
+	"hw-3/monitor"
+)
+
+// inspectTimeout limits the whole inspection, which makes a request per
+// phase and per cadence round.
+const inspectTimeout = 2 * time.Minute
+
+func formatTime(t time.Time) string {
+	if t.IsZero() || t.Unix() == 0 {
+		return "-"
+	}
+	return t.UTC().Format(time.RFC3339)
+}
+
+func writeInspection(w io.Writer, inspection *monitor.Inspection) error {
+	table := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(table, "Proxy\t%s\n", inspection.Address.Hex())
+	fmt.Fprintf(table, "Description\t%s\n", inspection.Description)
+	fmt.Fprintf(table, "Decimals\t%d\n", inspection.Decimals)
+	fmt.Fprintf(table, "Version\t%s\n", inspection.Version)
+	fmt.Fprintf(table, "Owner\t%s\n", inspection.Owner.Hex())
+	fmt.Fprintf(table, "Aggregator\t%s\n", inspection.Aggregator.Hex())
+	proposed := "-"
+	if inspection.ProposedAggregator != (common.Address{}) {
+		proposed = inspection.ProposedAggregator.Hex()
+	}
+	fmt.Fprintf(table, "Proposed aggregator\t%s\n", proposed)
+	fmt.Fprintf(table, "Latest price\t%s (round %s, updated %s)\n", inspection.Latest.Price,
+		inspection.Latest.RoundID, formatTime(inspection.Latest.UpdatedAt))
+	if cadence := inspection.Cadence; cadence != nil {
+		fmt.Fprintf(table, "Update cadence\tmedian %s, mean %s, min %s, max %s over %d rounds\n",
+			cadence.Median, cadence.Mean, cadence.Min, cadence.Max, cadence.Rounds)
+	} else {
+		fmt.Fprintln(table, "Update cadence\t-")
+	}
+
+	fmt.Fprintln(table, "\nPHASE\tAGGREGATOR\tFIRST ROUND\tFIRST UPDATE\tLAST ROUND\tLAST UPDATE")
+	for _, phase := range inspection.Phases {
+		if phase.Err != nil {
+			fmt.Fprintf(table, "%d\t%s\terror: %s\n", phase.ID, phase.Aggregator.Hex(), phase.Err)
+			continue
+		}
+		if phase.FirstRound == nil {
+			fmt.Fprintf(table, "%d\t%s\t-\t-\t-\t-\n", phase.ID, phase.Aggregator.Hex())
+			continue
+		}
+		fmt.Fprintf(table, "%d\t%s\t%s\t%s\t%s\t%s\n", phase.ID, phase.Aggregator.Hex(), phase.FirstRound,
+			formatTime(phase.FirstUpdatedAt), phase.LastRound, formatTime(phase.LastUpdatedAt))
+	}
+
+	fmt.Fprintln(table, "\nOWNERSHIP TRANSFER\tFROM\tTO\tTX")
+	for _, transfer := range inspection.Transfers {
+		fmt.Fprintf(table, "block %d\t%s\t%s\t%s\n", transfer.Block, transfer.From.Hex(), transfer.To.Hex(), transfer.TxHash.Hex())
+	}
+	if len(inspection.Transfers) == 0 {
+		fmt.Fprintln(table, "none found\t\t\t")
+	}
+	return table.Flush()
+}
+
+type phaseJSON struct {
+	Phase          uint16     `json:"phase"`
+	Aggregator     string     `json:"aggregator"`
+	FirstRound     string     `json:"firstRound,omitempty"`
+	FirstUpdatedAt *time.Time `json:"firstUpdatedAt,omitempty"`
+	LastRound      string     `json:"lastRound,omitempty"`
+	LastUpdatedAt  *time.Time `json:"lastUpdatedAt,omitempty"`
+	Error          string     `json:"error,omitempty"`
+}
+
+type transferJSON struct {
+	From   string `json:"from"`
+	To     string `json:"to"`
+	Block  uint64 `json:"block"`
+	TxHash string `json:"txHash"`
+}
+
+type cadenceJSON struct {
+	Rounds        int     `json:"rounds"`
+	MinSeconds    float64 `json:"minSeconds"`
+	MaxSeconds    float64 `json:"maxSeconds"`
+	MeanSeconds   float64 `json:"meanSeconds"`
+	MedianSeconds float64 `json:"medianSeconds"`
+}
+
+// inspectionJSON is the JSON output of the inspect command.
+type inspectionJSON struct {
+	Address            string          `json:"address"`
+	Description        string          `json:"description"`
+	Decimals           uint8           `json:"decimals"`
+	Version            string          `json:"version"`
+	Owner              string          `json:"owner"`
+	Aggregator         string          `json:"aggregator"`
+	ProposedAggregator string          `json:"proposedAggregator,omitempty"`
+	RoundID            string          `json:"roundId"`
+	Answer             string          `json:"answer"`
+	Price              decimal.Decimal `json:"price"`
+	UpdatedAt          time.Time       `json:"updatedAt"`
+	Phases             []phaseJSON     `json:"phases"`
+	Transfers          []transferJSON  `json:"ownershipTransfers"`
+	Cadence            *cadenceJSON    `json:"cadence,omitempty"`
+}
+
+func newInspectionJSON(inspection *monitor.Inspection) *inspectionJSON {
+	output := &inspectionJSON{
+		Address:     inspection.Address.Hex(),
+		Description: inspection.Description,
+		Decimals:    inspection.Decimals,
+		Version:     inspection.Version.String(),
+		Owner:       inspection.Owner.Hex(),
+		Aggregator:  inspection.Aggregator.Hex(),
+		RoundID:     inspection.Latest.RoundID.String(),
+		Answer:      inspection.Latest.Answer.String(),
+		Price:       inspection.Latest.Price,
+		UpdatedAt:   inspection.Latest.UpdatedAt.UTC(),
+		Phases:      []phaseJSON{},
+		Transfers:   []transferJSON{},
+	}
+	if inspection.ProposedAggregator != (common.Address{}) {
+		output.ProposedAggregator = inspection.ProposedAggregator.Hex()
+	}
+	for _, phase := range inspection.Phases {
+		encoded := phaseJSON{Phase: phase.ID, Aggregator: phase.Aggregator.Hex()}
+		switch {
+		case phase.Err != nil:
+			encoded.Error = phase.Err.Error()
+		case phase.FirstRound != nil:
+			first, last := phase.FirstUpdatedAt.UTC(), phase.LastUpdatedAt.UTC()
+			encoded.FirstRound = phase.FirstRound.String()
+			encoded.FirstUpdatedAt = &first
+			encoded.LastRound = phase.LastRound.String()
+			encoded.LastUpdatedAt = &last
+		}
+		output.Phases = append(output.Phases, encoded)
+	}
+	for _, transfer := range inspection.Transfers {
+		output.Transfers = append(output.Transfers, transferJSON{
+			From:   transfer.From.Hex(),
+			To:     transfer.To.Hex(),
+			Block:  transfer.Block,
+			TxHash: transfer.TxHash.Hex(),
+		})
+	}
+	if cadence := inspection.Cadence; cadence != nil {
+		output.Cadence = &cadenceJSON{
+			Rounds:        cadence.Rounds,
+			MinSeconds:    cadence.Min.Seconds(),
+			MaxSeconds:    cadence.Max.Seconds(),
+			MeanSeconds:   cadence.Mean.Seconds(),
+			MedianSeconds: cadence.Median.Seconds(),
+		}
+	}
+	return output
+}
+
+// runInspect implements the inspect command, printing everything known
+// about the feed with the given proxy address.
+func runInspect(args []string) int {
+	flags := flag.NewFlagSet("inspect", flag.ContinueOnError)
+	jsonOutput := flags.Bool("json", false, "print JSON instead of text")
+	fromBlock := flags.Uint64("from-block", 0, "block to search for ownership transfers from")
+	rounds := flags.Int("rounds", 20, "number of recent rounds to compute the update cadence from")
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "Usage: hw-3 inspect [flags] <proxy-address or ENS name>")
+		flags.PrintDefaults()
+	}
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+	if flags.NArg() != 1 {
+		flags.Usage()
+		return 2
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), inspectTimeout)
+	defer cancel()
+	client, err := dialNode(ctx)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed dialing node: %s\n", err)
+		return 1
+	}
+	defer client.Close()
+
+	address := common.HexToAddress(flags.Arg(0))
+	if !common.IsHexAddress(flags.Arg(0)) {
+		address, err = monitor.ResolveName(&bind.CallOpts{Context: ctx}, client, flags.Arg(0))
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Failed resolving %s: %s\n", flags.Arg(0), err)
+			return 1
+		}
+	}
+	inspection, err := monitor.Inspect(ctx, client, address, monitor.InspectOptions{
+		FromBlock:     *fromBlock,
+		CadenceRounds: *rounds,
+	})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed inspecting feed: %s\n", err)
+		return 1
+	}
+
+	if *jsonOutput {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		err = encoder.Encode(newInspectionJSON(inspection))
+	} else {
+		err = writeInspection(os.Stdout, inspection)
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed writing output: %s\n", err)
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,151 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"text/tabwriter"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"hw-3/decimal"
+	"hw-3/monitor"
+)
+
+const defaultMaxAge = 24 * time.Hour
+
+// feedStatus is a row of the latest command output.
+type feedStatus struct {
+	Feed        string           `json:"feed"`
+	Address     string           `json:"address"`
+	Name        string           `json:"name,omitempty"`
+	Description string           `json:"description,omitempty"`
+	Version     string           `json:"version,omitempty"`
+	PhaseID     uint16           `json:"phaseId,omitempty"`
+	RoundID     string           `json:"roundId,omitempty"`
+	Answer      string           `json:"answer,omitempty"`
+	Decimals    uint8            `json:"decimals,omitempty"`
+	Price       *decimal.Decimal `json:"price,omitempty"`
+	UpdatedAt   *time.Time       `json:"updatedAt,omitempty"`
+	AgeSeconds  int64            `json:"ageSeconds,omitempty"`
+	Stale       bool             `json:"stale"`
+	Error       string           `json:"error,omitempty"`
+}
+
+func (s *feedStatus) healthy() bool {
+	return s.Error == "" && !s.Stale
+}
+
+func (s *feedStatus) state() string {
+	switch {
+	case s.Error != "":
+		return "error: " + s.Error
+	case s.Stale:
+		return "stale"
+	}
+	return "ok"
+}
+
+// queryFeeds queries all feeds in parallel, each limited to queryTimeout.
+func queryFeeds(ctx context.Context, backend bind.ContractCaller, feeds []monitor.Feed, maxAge time.Duration, now time.Time) []*feedStatus {
+	statuses := make([]*feedStatus, len(feeds))
+	wg := sync.WaitGroup{}
+	for i, feed := range feeds {
+		wg.Add(1)
+		go func(i int, feed monitor.Feed) {
+			defer wg.Done()
+			timeoutCtx, cancel := context.WithTimeout(ctx, queryTimeout)
+			defer cancel()
+
+			status := &feedStatus{Feed: feed.Tokens, Address: feed.Address.Hex(), Name: feed.Name}
+			statuses[i] = status
+			snapshot, err := monitor.QuerySnapshot(timeoutCtx, backend, feed)
+			if err != nil {
+				status.Error = err.Error()
+				return
+			}
+			age := now.Sub(snapshot.UpdatedAt)
+			status.Address = snapshot.Feed.Address.Hex()
+			status.Description = snapshot.Description
+			status.Version = snapshot.Version.String()
+			status.PhaseID = snapshot.PhaseID
+			status.RoundID = snapshot.RoundID.String()
+			status.Answer = snapshot.Answer.String()
+			status.Decimals = snapshot.Decimals
+			status.Price = &snapshot.Price
+			status.UpdatedAt = &snapshot.UpdatedAt
+			status.AgeSeconds = int64(age / time.Second)
+			status.Stale = age > maxAge
+		}(i, feed)
+	}
+	wg.Wait()
+	return statuses
+}
+
+func writeStatusTable(w io.Writer, statuses []*feedStatus) error {
+	table := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(table, "FEED\tPRICE\tAGE\tROUND\tPHASE\tVERSION\tDESCRIPTION\tSTATUS")
+	for _, status := range statuses {
+		if status.Error != "" {
+			fmt.Fprintf(table, "%s\t-\t-\t-\t-\t-\t-\t%s\n", status.Feed, status.state())
+			continue
+		}
+		age := time.Duration(status.AgeSeconds) * time.Second
+		fmt.Fprintf(table, "%s\t%s\t%s\t%s\t%d\t%s\t%s\t%s\n", status.Feed, status.Price, age,
+			status.RoundID, status.PhaseID, status.Version, status.Description, status.state())
+	}
+	return table.Flush()
+}
+
+// runLatest implements the latest command: it prints the latest round of
+// every configured feed and returns the exit code, non-zero if any feed is
+// stale or could not be queried.
+func runLatest(args []string) int {
+	flags := flag.NewFlagSet("latest", flag.ContinueOnError)
+	jsonOutput := flags.Bool("json", false, "print JSON instead of a table")
+	maxAge := flags.Duration("max-age", defaultMaxAge, "report feeds not updated for longer as stale")
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+
+	feedConf, err := parseFeedConfig("feed.yaml")
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed parsing feed config: %s\n", err)
+		return 1
+	}
+
+	ctx := context.Background()
+	timeoutCtx, cancel := context.WithTimeout(ctx, queryTimeout)
+	client, err := dialNode(timeoutCtx)
+	cancel()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed dialing node: %s\n", err)
+		return 1
+	}
+	defer client.Close()
+
+	feeds, _ := monitorFeeds(feedConf)
+	statuses := queryFeeds(ctx, client, feeds, *maxAge, time.Now())
+	if *jsonOutput {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		err = encoder.Encode(statuses)
+	} else {
+		err = writeStatusTable(os.Stdout, statuses)
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed writing output: %s\n", err)
+		return 1
+	}
+
+	for _, status := range statuses {
+		if !status.healthy() {
+			return 1
+		}
+	}
+	return 0
+}
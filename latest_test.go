@@ -0,0 +1,116 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"hw-3/ens"
+	"hw-3/fakenode"
+	"hw-3/monitor"
+	"hw-3/proxy"
+)
+
+var (
+	ethUSDAddress = common.HexToAddress("0x5f4eC3Df9cbd43714FE2740f5E3616155c5b8419")
+	btcUSDAddress = common.HexToAddress("0xF4030086522a5bEEa4988F8cA5B36dbC97BeE88c")
+)
+
+func parseABI(t *testing.T, abiJSON string) abi.ABI {
+	t.Helper()
+	parsed, err := abi.JSON(strings.NewReader(abiJSON))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return parsed
+}
+
+// serveProxy makes node answer the calls of the latest command to the proxy
+// at address with a round of answer updated at updatedAt.
+func serveProxy(t *testing.T, node *fakenode.Node, address common.Address, description string, answer int64, updatedAt time.Time) {
+	t.Helper()
+	proxyABI := parseABI(t, proxy.ProxyMetaData.ABI)
+	roundID := monitor.ProxyRoundID(2, big.NewInt(7))
+	for _, err := range []error{
+		node.SetCallResult(address, proxyABI, "decimals", uint8(8)),
+		node.SetCallResult(address, proxyABI, "description", description),
+		node.SetCallResult(address, proxyABI, "version", big.NewInt(4)),
+		node.SetCallResult(address, proxyABI, "phaseId", uint16(2)),
+		node.SetCallResult(address, proxyABI, "latestRoundData",
+			roundID, big.NewInt(answer), big.NewInt(updatedAt.Unix()), big.NewInt(updatedAt.Unix()), roundID),
+	} {
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestLatest(t *testing.T) {
+	node, err := fakenode.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer node.Close()
+	now := time.Now()
+	serveProxy(t, node, ethUSDAddress, "ETH / USD", 2000_00000000, now.Add(-time.Minute))
+	serveProxy(t, node, btcUSDAddress, "BTC / USD", 40000_00000000, now.Add(-48*time.Hour))
+	// btc-usd.data.eth resolves to the BTC / USD proxy
+	resolver := common.HexToAddress("0x4976fb03C32e5B8cfe2b6cCB31c09Ba78EBaBa41")
+	for _, err := range []error{
+		node.SetCallResult(ens.RegistryAddress, parseABI(t, ens.ENSMetaData.ABI), "resolver", resolver),
+		node.SetCallResult(resolver, parseABI(t, ens.ResolverMetaData.ABI), "addr", btcUSDAddress),
+	} {
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	client, err := ethclient.Dial(node.URL())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+
+	statuses := queryFeeds(context.Background(), client, []monitor.Feed{
+		{Tokens: "ETH / USD", Address: ethUSDAddress},
+		{Tokens: "BTC / USD", Name: "btc-usd.data.eth"},
+		{Tokens: "LINK / USD", Address: common.HexToAddress("0x2c1d072e956AFFC0D435Cb7AC38EF18d24d9127c")},
+	}, defaultMaxAge, now)
+
+	if ethUSD := statuses[0]; !ethUSD.healthy() || ethUSD.Price.String() != "2000.00000000" || ethUSD.AgeSeconds != 60 {
+		t.Errorf("expected ETH / USD at 2000.00000000 updated 60s ago, got %s %ds ago (%s)", ethUSD.Price, ethUSD.AgeSeconds, ethUSD.state())
+	}
+	if btcUSD := statuses[1]; !btcUSD.Stale || btcUSD.Address != btcUSDAddress.Hex() || btcUSD.Name != "btc-usd.data.eth" {
+		t.Errorf("expected stale BTC / USD at %s resolved from btc-usd.data.eth, got %s at %s (%s)",
+			btcUSDAddress.Hex(), btcUSD.Name, btcUSD.Address, btcUSD.state())
+	}
+	if statuses[2].Error == "" {
+		t.Error("expected querying LINK / USD to fail")
+	}
+
+	var table bytes.Buffer
+	if err := writeStatusTable(&table, statuses); err != nil {
+		t.Fatal(err)
+	}
+	rows := strings.Split(strings.TrimSpace(table.String()), "\n")
+	if len(rows) != 4 {
+		t.Fatalf("expected a header and 3 rows, got:\n%s", table.String())
+	}
+	for i, expected := range [][]string{
+		{"FEED", "PRICE", "AGE", "ROUND", "PHASE", "VERSION", "DESCRIPTION", "STATUS"},
+		{"ETH / USD", "2000.00000000", "1m0s", "36893488147419103239", "2", "4", "ETH / USD", "ok"},
+		{"BTC / USD", "40000.00000000", "48h0m0s", "36893488147419103239", "2", "4", "BTC / USD", "stale"},
+		{"LINK / USD", "-", "error: "},
+	} {
+		for _, column := range expected {
+			if !strings.Contains(rows[i], column) {
+				t.Errorf("expected row %d to contain %q, got %q", i, column, rows[i])
+			}
+		}
+	}
+}
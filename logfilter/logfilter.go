@@ -0,0 +1,44 @@
+// Package logfilter matches logs against filter queries the way a node does,
+// for backends serving subscriptions without one.
+package logfilter
+
+import (
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// Match reports whether vLog passes the address and topic filters of query.
+// The block range of query is not checked.
+func Match(query ethereum.FilterQuery, vLog *types.Log) bool {
+	if len(query.Addresses) > 0 && !containsAddress(query.Addresses, vLog.Address) {
+		return false
+	}
+	for i, topics := range query.Topics {
+		if len(topics) == 0 {
+			continue
+		}
+		if i >= len(vLog.Topics) || !containsHash(topics, vLog.Topics[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func containsAddress(addresses []common.Address, address common.Address) bool {
+	for _, a := range addresses {
+		if a == address {
+			return true
+		}
+	}
+	return false
+}
+
+func containsHash(hashes []common.Hash, hash common.Hash) bool {
+	for _, h := range hashes {
+		if h == hash {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,39 @@
+package logfilter
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+func TestMatch(t *testing.T) {
+	address := common.HexToAddress("0x01")
+	other := common.HexToAddress("0x02")
+	topic0 := common.HexToHash("0x10")
+	topic1 := common.HexToHash("0x11")
+	vLog := &types.Log{Address: address, Topics: []common.Hash{topic0, topic1}}
+
+	tests := []struct {
+		name    string
+		query   ethereum.FilterQuery
+		matches bool
+	}{
+		{"empty query", ethereum.FilterQuery{}, true},
+		{"address", ethereum.FilterQuery{Addresses: []common.Address{other, address}}, true},
+		{"other address", ethereum.FilterQuery{Addresses: []common.Address{other}}, false},
+		{"first topic", ethereum.FilterQuery{Topics: [][]common.Hash{{topic1, topic0}}}, true},
+		{"other first topic", ethereum.FilterQuery{Topics: [][]common.Hash{{topic1}}}, false},
+		{"wildcard topic", ethereum.FilterQuery{Topics: [][]common.Hash{{}, {topic1}}}, true},
+		{"missing topic", ethereum.FilterQuery{Topics: [][]common.Hash{{}, {}, {topic0}}}, false},
+		{"trailing wildcards", ethereum.FilterQuery{Topics: [][]common.Hash{{topic0}, {}, {}}}, true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := Match(test.query, vLog); got != test.matches {
+				t.Errorf("expected match %t, got %t", test.matches, got)
+			}
+		})
+	}
+}
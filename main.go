@@ -2,34 +2,126 @@ package main
 
 import (
 	"context"
+	"errors"
+	"flag"
 	"fmt"
-	"github.com/ethereum/go-ethereum/common"
-	log "github.com/sirupsen/logrus"
-	"gopkg.in/yaml.v2"
-	"hw-3/aggregator"
-	"hw-3/proxy"
-	"math/big"
 	"os"
 	"os/signal"
+	"strings"
 	"sync"
 	"syscall"
 	"time"
 
-	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/ethclient/gethclient"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/rpc"
+	log "github.com/sirupsen/logrus"
+	"gopkg.in/yaml.v2"
+	"hw-3/capture"
+	"hw-3/monitor"
+	"hw-3/sink"
+	"hw-3/stream"
 )
 
 const (
-	queryTimeout = 30 * time.Second
+	queryTimeout     = 30 * time.Second
+	defaultQueueSize = 1024
+	streamBufferSize = 1024
+	replayDrainDelay = time.Second
+	defaultNetwork   = "mainnet"
+	logFormatText    = "text"
+	logFormatJSON    = "json"
+)
+
+// chainConfigs are the chain configs of the networks with known fork rules.
+var chainConfigs = map[string]*params.ChainConfig{
+	"mainnet": params.MainnetChainConfig,
+	"goerli":  params.GoerliChainConfig,
+	"sepolia": params.SepoliaChainConfig,
+}
+
+var (
+	recordPath  = flag.String("record", "", "write every header, log and call result received from the node to a capture file")
+	replayPath  = flag.String("replay", "", "replay a capture file instead of connecting to a node")
+	replaySpeed = flag.Float64("speed", 1, "replay speed relative to the recording, 0 replays as fast as possible")
 )
 
+// feedData is a feed given either by its proxy address, by an ENS name
+// resolving to the proxy or by the base and quote assets it has in the Feed
+// Registry.
 type feedData struct {
-	Tokens  string `yaml:"tokens"`
+	Tokens   string    `yaml:"tokens"`
+	Address  string    `yaml:"address"`
+	Base     string    `yaml:"base"`
+	Quote    string    `yaml:"quote"`
+	Registry string    `yaml:"registry"`
+	DEX      []dexData `yaml:"dex"`
+}
+
+type dexData struct {
+	Type    string `yaml:"type"`
 	Address string `yaml:"address"`
+	Inverse bool   `yaml:"inverse"`
+}
+
+type contractData struct {
+	Name    string   `yaml:"name"`
+	Address string   `yaml:"address"`
+	ABI     string   `yaml:"abi"`
+	Events  []string `yaml:"events"`
+}
+
+// groupData lists feeds that should track each other by their tokens.
+type groupData struct {
+	Name      string        `yaml:"name"`
+	Threshold float64       `yaml:"threshold"`
+	Duration  time.Duration `yaml:"duration"`
+	Members   []memberData  `yaml:"members"`
+}
+
+// memberData refers to feeds of the network named by Network, the configured
+// feeds if it is empty.
+type memberData struct {
+	Feed    string   `yaml:"feed"`
+	Via     []string `yaml:"via"`
+	Network string   `yaml:"network"`
+}
+
+// networkData is another network divergence group members can be on, with
+// the feeds they refer to there. Environment variables in URL are expanded,
+// so API keys don't need to be part of the config.
+type networkData struct {
+	Name                string     `yaml:"name"`
+	URL                 string     `yaml:"url"`
+	SequencerUptimeFeed string     `yaml:"sequencerUptimeFeed"`
+	Feeds               []feedData `yaml:"feeds"`
+}
+
+type sinkData struct {
+	Type  string `yaml:"type"`
+	Path  string `yaml:"path"`
+	URL   string `yaml:"url"`
+	Queue int    `yaml:"queue"`
 }
 
 type feedConfig struct {
-	Feeds []feedData `yaml:"feeds"`
+	Network              string         `yaml:"network"`
+	LogFormat            string         `yaml:"logFormat"`
+	Listen               string         `yaml:"listen"`
+	GRPCListen           string         `yaml:"grpcListen"`
+	SequencerUptimeFeed  string         `yaml:"sequencerUptimeFeed"`
+	SequencerGracePeriod time.Duration  `yaml:"sequencerGracePeriod"`
+	UpdateCosts          bool           `yaml:"updateCosts"`
+	Mempool              bool           `yaml:"mempool"`
+	Feeds                []feedData     `yaml:"feeds"`
+	Contracts            []contractData `yaml:"contracts"`
+	Groups               []groupData    `yaml:"groups"`
+	Networks             []networkData  `yaml:"networks"`
+	Sinks                []sinkData     `yaml:"sinks"`
 }
 
 func parseFeedConfig(configFileName string) (*feedConfig, error) {
@@ -48,114 +140,366 @@ func parseFeedConfig(configFileName string) (*feedConfig, error) {
 	return &feedConf, nil
 }
 
-func subscribeBlocks(ctx context.Context, client *ethclient.Client, wg *sync.WaitGroup) {
-	defer wg.Done()
+func parseContractABI(abiFileName string) (*abi.ABI, error) {
+	abiFile, err := os.Open(abiFileName)
+	if err != nil {
+		return nil, fmt.Errorf("failed opening ABI file %s: %w", abiFileName, err)
+	}
+	defer abiFile.Close()
 
-	headers := make(chan *types.Header)
-	sub, err := client.SubscribeNewHead(ctx, headers)
+	contractABI, err := abi.JSON(abiFile)
 	if err != nil {
-		log.Errorf("Failed subscribing to block creation: %s", err)
-		return
+		return nil, fmt.Errorf("failed decoding ABI from file %s: %w", abiFileName, err)
 	}
+	return &contractABI, nil
+}
 
-	log.Info("Monitoring blocks")
-	for {
-		select {
-		case err := <-sub.Err():
-			log.Errorf("Failed while listnening for new blocks: %s", err)
-			return
-		case <-ctx.Done():
-			return
-		case header := <-headers:
-			timeoutCtx, cancel := context.WithTimeout(ctx, queryTimeout)
-			block, err := client.BlockByHash(timeoutCtx, header.Hash())
-			cancel()
-			if err != nil {
-				log.Errorf("Failed getting block by hash: %s", err)
-				return
-			}
+func registryFeed(feed feedData) (monitor.Feed, error) {
+	base, err := resolveAsset(feed.Base)
+	if err != nil {
+		return monitor.Feed{}, fmt.Errorf("failed resolving base: %w", err)
+	}
+	quote, err := resolveAsset(feed.Quote)
+	if err != nil {
+		return monitor.Feed{}, fmt.Errorf("failed resolving quote: %w", err)
+	}
+	registry := feed.Registry
+	if registry == "" {
+		registry = mainnetFeedRegistry
+	}
+	if !common.IsHexAddress(registry) {
+		return monitor.Feed{}, fmt.Errorf("invalid registry address %q", registry)
+	}
+	tokens := feed.Tokens
+	if tokens == "" {
+		tokens = strings.ToUpper(feed.Base) + " / " + strings.ToUpper(feed.Quote)
+	}
+	return monitor.Feed{
+		Tokens:  tokens,
+		Address: common.HexToAddress(registry),
+		Base:    base,
+		Quote:   quote,
+	}, nil
+}
+
+// network returns the configured network, mainnet by default.
+func (c *feedConfig) network() string {
+	if c.Network == "" {
+		return defaultNetwork
+	}
+	return c.Network
+}
+
+// monitorFeed converts a configured feed, addresses that aren't hex are ENS
+// names.
+func monitorFeed(feed feedData) (monitor.Feed, error) {
+	if feed.Base != "" || feed.Quote != "" {
+		return registryFeed(feed)
+	}
+	if !common.IsHexAddress(feed.Address) {
+		tokens := feed.Tokens
+		if tokens == "" {
+			tokens = feed.Address
+		}
+		return monitor.Feed{Tokens: tokens, Name: feed.Address}, nil
+	}
+	return monitor.Feed{
+		Tokens:  feed.Tokens,
+		Address: common.HexToAddress(feed.Address),
+	}, nil
+}
+
+// monitorFeeds returns the configured feeds and the DEX pools compared with
+// them.
+func monitorFeeds(feedConf *feedConfig) ([]monitor.Feed, []monitor.DEXPool) {
+	feeds := make([]monitor.Feed, 0, len(feedConf.Feeds))
+	var pools []monitor.DEXPool
+	for _, feed := range feedConf.Feeds {
+		resolved, err := monitorFeed(feed)
+		if err != nil {
 			log.WithFields(log.Fields{
-				"number":       block.Number().Uint64(),
-				"transactions": len(block.Transactions()),
-			}).Info("New block")
+				"base":  feed.Base,
+				"quote": feed.Quote,
+			}).Errorf("Failed resolving registry feed: %s", err)
+			continue
+		}
+		feeds = append(feeds, resolved)
+		for _, pool := range feed.DEX {
+			kind := monitor.DEXKind(pool.Type)
+			if kind != monitor.UniswapV2 && kind != monitor.UniswapV3 {
+				log.WithFields(log.Fields{
+					"tokens":      resolved.Tokens,
+					"poolAddress": pool.Address,
+				}).Errorf("Unknown DEX pool type %q", pool.Type)
+				continue
+			}
+			pools = append(pools, monitor.DEXPool{
+				Feed:    resolved,
+				Kind:    kind,
+				Address: common.HexToAddress(pool.Address),
+				Inverse: pool.Inverse,
+			})
 		}
 	}
+	return feeds, pools
 }
 
-func logFeedError(feedHexAddress, tokens, msg string, err error) {
-	log.WithFields(log.Fields{
-		"feedAddress": feedHexAddress,
-		"tokens":      tokens,
-	}).Errorf("%s: %s", msg, err)
+// networkFeeds returns the feeds of the other networks by network name.
+// Their ENS names can't be resolved, only those on the monitored network are.
+func networkFeeds(networks []networkData) map[string][]monitor.Feed {
+	feeds := make(map[string][]monitor.Feed, len(networks))
+	for _, network := range networks {
+		feeds[network.Name] = []monitor.Feed{}
+		for _, feed := range network.Feeds {
+			resolved, err := monitorFeed(feed)
+			if err == nil && resolved.Name != "" {
+				err = errors.New("ENS names are only resolved on the monitored network")
+			}
+			if err != nil {
+				log.WithFields(log.Fields{
+					"network": network.Name,
+					"tokens":  feed.Tokens,
+				}).Errorf("Failed resolving network feed: %s", err)
+				continue
+			}
+			feeds[network.Name] = append(feeds[network.Name], resolved)
+		}
+	}
+	return feeds
 }
 
-func subscribeEvents(ctx context.Context, client *ethclient.Client, feedHexAddress, tokens string, wg *sync.WaitGroup) {
-	defer wg.Done()
+// divergenceGroup resolves the members of a group among the configured feeds,
+// or among the feeds of their network.
+func divergenceGroup(group groupData, feeds []monitor.Feed, networks map[string][]monitor.Feed) (monitor.DivergenceGroup, error) {
+	byTokens := make(map[string]map[string]monitor.Feed, len(networks)+1)
+	byTokens[""] = make(map[string]monitor.Feed, len(feeds))
+	for _, feed := range feeds {
+		byTokens[""][feed.Tokens] = feed
+	}
+	for network, networkFeeds := range networks {
+		byTokens[network] = make(map[string]monitor.Feed, len(networkFeeds))
+		for _, feed := range networkFeeds {
+			byTokens[network][feed.Tokens] = feed
+		}
+	}
+	lookup := func(network, tokens string) (monitor.Feed, error) {
+		networkFeeds, ok := byTokens[network]
+		if !ok {
+			return monitor.Feed{}, fmt.Errorf("network %q is not configured", network)
+		}
+		feed, ok := networkFeeds[tokens]
+		if !ok && network != "" {
+			return monitor.Feed{}, fmt.Errorf("feed %q is not configured on %s", tokens, network)
+		}
+		if !ok {
+			return monitor.Feed{}, fmt.Errorf("feed %q is not configured", tokens)
+		}
+		return feed, nil
+	}
 
-	proxyAddress := common.HexToAddress(feedHexAddress)
-	proxyInstance, err := proxy.NewProxy(proxyAddress, client)
-	if err != nil {
-		logFeedError(feedHexAddress, tokens, "Failed acquiring proxy instance", err)
-		return
+	if len(group.Members) < 2 {
+		return monitor.DivergenceGroup{}, fmt.Errorf("expected at least 2 members, got %d", len(group.Members))
+	}
+	resolved := monitor.DivergenceGroup{
+		Name:      group.Name,
+		Threshold: group.Threshold,
+		Duration:  group.Duration,
+	}
+	for _, member := range group.Members {
+		feed, err := lookup(member.Network, member.Feed)
+		if err != nil {
+			return monitor.DivergenceGroup{}, err
+		}
+		groupMember := monitor.GroupMember{Feed: feed, Network: member.Network}
+		for _, tokens := range member.Via {
+			via, err := lookup(member.Network, tokens)
+			if err != nil {
+				return monitor.DivergenceGroup{}, err
+			}
+			groupMember.Via = append(groupMember.Via, via)
+		}
+		resolved.Members = append(resolved.Members, groupMember)
+	}
+	return resolved, nil
+}
+
+func monitorConfig(feedConf *feedConfig) monitor.Config {
+	feeds, pools := monitorFeeds(feedConf)
+	config := monitor.Config{
+		Feeds:                feeds,
+		DEXPools:             pools,
+		SequencerGracePeriod: feedConf.SequencerGracePeriod,
+		UpdateCosts:          feedConf.UpdateCosts,
+		Mempool:              feedConf.Mempool,
+		ChainConfig:          chainConfigs[feedConf.network()],
+	}
+	if feedConf.SequencerUptimeFeed != "" {
+		config.SequencerUptimeFeed = common.HexToAddress(feedConf.SequencerUptimeFeed)
+	}
+	networks := networkFeeds(feedConf.Networks)
+	for _, group := range feedConf.Groups {
+		resolved, err := divergenceGroup(group, feeds, networks)
+		if err != nil {
+			log.WithField("group", group.Name).Errorf("Failed resolving divergence group: %s", err)
+			continue
+		}
+		config.DivergenceGroups = append(config.DivergenceGroups, resolved)
 	}
+	for _, contract := range feedConf.Contracts {
+		contractABI, err := parseContractABI(contract.ABI)
+		if err != nil {
+			log.WithFields(log.Fields{
+				"contract":        contract.Name,
+				"contractAddress": contract.Address,
+			}).Errorf("Failed parsing contract ABI: %s", err)
+			continue
+		}
+		config.Contracts = append(config.Contracts, monitor.Contract{
+			Name:    contract.Name,
+			Address: common.HexToAddress(contract.Address),
+			ABI:     *contractABI,
+			Events:  contract.Events,
+		})
+	}
+	return config
+}
 
-	aggregatorAddress, err := proxyInstance.Aggregator(nil)
-	if err != nil {
-		logFeedError(feedHexAddress, tokens, "Failed acquiring aggregator address", err)
-		return
+func dialNode(ctx context.Context) (*ethclient.Client, error) {
+	return ethclient.DialContext(ctx, os.Getenv("ALCHEMY_URL"))
+}
+
+// dialNetworks connects to the other networks, one client each is shared by
+// all divergence group members on it.
+func dialNetworks(ctx context.Context, networks []networkData) (map[string]monitor.Network, []*ethclient.Client, error) {
+	backends := make(map[string]monitor.Network, len(networks))
+	clients := make([]*ethclient.Client, 0, len(networks))
+	for _, network := range networks {
+		client, err := ethclient.DialContext(ctx, os.ExpandEnv(network.URL))
+		if err != nil {
+			for _, client := range clients {
+				client.Close()
+			}
+			return nil, nil, fmt.Errorf("failed dialing %s: %w", network.Name, err)
+		}
+		backends[network.Name] = monitor.Network{
+			Backend:             client,
+			SequencerUptimeFeed: common.HexToAddress(network.SequencerUptimeFeed),
+		}
+		clients = append(clients, client)
 	}
+	return backends, clients, nil
+}
+
+// nodeClient is an ethclient that also streams pending transactions through
+// the geth API.
+type nodeClient struct {
+	*ethclient.Client
+	geth *gethclient.Client
+}
 
-	aggregatorInstance, err := aggregator.NewAggregator(aggregatorAddress, client)
+func dialNodeClient(ctx context.Context) (*nodeClient, error) {
+	rpcClient, err := rpc.DialContext(ctx, os.Getenv("ALCHEMY_URL"))
 	if err != nil {
-		logFeedError(feedHexAddress, tokens, "Failed acquiring aggregator instance", err)
-		return
+		return nil, err
 	}
+	return &nodeClient{Client: ethclient.NewClient(rpcClient), geth: gethclient.New(rpcClient)}, nil
+}
 
-	aggregatorDecimals, err := aggregatorInstance.Decimals(nil)
-	if err != nil {
-		logFeedError(feedHexAddress, tokens, "Failed acquiring decimals", err)
-		return
+func (c *nodeClient) SubscribePendingTransactions(ctx context.Context, ch chan<- common.Hash) (ethereum.Subscription, error) {
+	return c.geth.SubscribePendingTransactions(ctx, ch)
+}
+
+func configureLogging(format string) error {
+	switch format {
+	case "", logFormatText:
+		log.SetFormatter(&log.TextFormatter{})
+	case logFormatJSON:
+		log.SetFormatter(&log.JSONFormatter{})
+	default:
+		return fmt.Errorf("unknown log format %q", format)
 	}
+	return nil
+}
 
-	decimalsDivInt := big.NewInt(10)
-	decimalsDivInt.Exp(decimalsDivInt, big.NewInt(int64(aggregatorDecimals)), nil)
-	decimalsDivFloat := new(big.Float).SetInt(decimalsDivInt)
+func newSink(sinkConf sinkData, logFormat string) (sink.Sink, error) {
+	switch sinkConf.Type {
+	case "log":
+		if logFormat == logFormatJSON {
+			return sink.NewStructuredLog(), nil
+		}
+		return sink.NewLog(), nil
+	case "json":
+		return sink.NewJSONLines(sinkConf.Path)
+	case "csv":
+		return sink.NewCSV(sinkConf.Path)
+	case "webhook":
+		if sinkConf.URL == "" {
+			return nil, errors.New("webhook sink requires url")
+		}
+		return sink.NewWebhook(sinkConf.URL), nil
+	default:
+		return nil, fmt.Errorf("unknown sink type %q", sinkConf.Type)
+	}
+}
 
-	ansChan := make(chan *aggregator.AggregatorAnswerUpdated)
-	sub, err := aggregatorInstance.WatchAnswerUpdated(nil, ansChan, nil, nil)
-	if err != nil {
-		logFeedError(feedHexAddress, tokens, "Failed subscribing to price updates", err)
-		return
+func buildSinks(sinkConfs []sinkData, logFormat string) (sink.Multi, error) {
+	if len(sinkConfs) == 0 {
+		sinkConfs = []sinkData{{Type: "log"}}
 	}
 
-	log.WithFields(log.Fields{
-		"feedAddress": feedHexAddress,
-		"tokens":      tokens,
-	}).Info("Monitoring price")
-	for {
-		select {
-		case err := <-sub.Err():
-			logFeedError(feedHexAddress, tokens, "Failed while listening for events", err)
-			return
-		case <-ctx.Done():
-			return
-		case ans := <-ansChan:
-			newPrice := new(big.Float).Quo(new(big.Float).SetInt(ans.Current), decimalsDivFloat)
-			log.WithFields(log.Fields{
-				"tokens": tokens,
-				"price":  newPrice.Text('f', int(aggregatorDecimals)),
-			}).Info("New price")
+	sinks := make(sink.Multi, 0, len(sinkConfs))
+	for _, sinkConf := range sinkConfs {
+		s, err := newSink(sinkConf, logFormat)
+		if err != nil {
+			sinks.Close()
+			return nil, fmt.Errorf("failed creating %s sink: %w", sinkConf.Type, err)
+		}
+		queueSize := sinkConf.Queue
+		if queueSize <= 0 {
+			queueSize = defaultQueueSize
 		}
+		sinks = append(sinks, sink.NewQueued(sinkConf.Type, s, queueSize))
 	}
+	return sinks, nil
 }
 
 func main() {
+	flag.Parse()
+	switch flag.Arg(0) {
+	case "latest":
+		os.Exit(runLatest(flag.Args()[1:]))
+	case "inspect":
+		os.Exit(runInspect(flag.Args()[1:]))
+	}
+	if *recordPath != "" && *replayPath != "" {
+		log.Error("Recording and replaying at the same time is not supported")
+		return
+	}
+
 	feedConf, err := parseFeedConfig("feed.yaml")
 	if err != nil {
 		log.Errorf("Failed parsing feed config: %s", err)
 		return
 	}
 
+	if err := configureLogging(feedConf.LogFormat); err != nil {
+		log.Errorf("Failed configuring logging: %s", err)
+		return
+	}
+	network := feedConf.network()
+
+	sinks, err := buildSinks(feedConf.Sinks, feedConf.LogFormat)
+	if err != nil {
+		log.Errorf("Failed creating sinks: %s", err)
+		return
+	}
+	var hub *stream.Hub
+	if feedConf.Listen != "" || feedConf.GRPCListen != "" {
+		hub = stream.NewHub(streamBufferSize)
+		sinks = append(sinks, hub)
+	}
+	defer sinks.Close()
+
 	background := context.Background()
 	termCtx, termCancel := context.WithCancel(background)
 	sigs := make(chan os.Signal, 1)
@@ -165,21 +509,94 @@ func main() {
 		termCancel()
 	}()
 
-	timeoutCtx, timeoutCancel := context.WithTimeout(termCtx, queryTimeout)
-	client, err := ethclient.DialContext(timeoutCtx, os.Getenv("ALCHEMY_URL"))
-	timeoutCancel()
-	if err != nil {
-		log.Errorf("Failed dialing node: %s", err)
-		return
+	var backend capture.Backend
+	var replay *capture.Replay
+	if *replayPath != "" {
+		replay, err = capture.Open(*replayPath)
+		if err != nil {
+			log.Errorf("Failed loading capture: %s", err)
+			return
+		}
+		backend = replay
+	} else {
+		timeoutCtx, timeoutCancel := context.WithTimeout(termCtx, queryTimeout)
+		client, err := dialNodeClient(timeoutCtx)
+		timeoutCancel()
+		if err != nil {
+			log.Errorf("Failed dialing node: %s", err)
+			return
+		}
+		backend = client
+
+		if *recordPath != "" {
+			recorder, err := capture.NewRecorder(*recordPath, client)
+			if err != nil {
+				log.Errorf("Failed starting recording: %s", err)
+				return
+			}
+			defer func() {
+				if err := recorder.Close(); err != nil {
+					log.Errorf("Failed writing capture: %s", err)
+				}
+			}()
+			backend = recorder
+		}
+	}
+
+	config := monitorConfig(feedConf)
+	if len(feedConf.Networks) > 0 {
+		// Captures only hold the data of the monitored network
+		if *recordPath != "" || *replayPath != "" {
+			log.Error("Recording or replaying with other networks configured is not supported")
+			return
+		}
+		timeoutCtx, timeoutCancel := context.WithTimeout(termCtx, queryTimeout)
+		networks, clients, err := dialNetworks(timeoutCtx, feedConf.Networks)
+		timeoutCancel()
+		if err != nil {
+			log.Errorf("Failed dialing network: %s", err)
+			return
+		}
+		defer func() {
+			for _, client := range clients {
+				client.Close()
+			}
+		}()
+		config.Networks = networks
 	}
+	mon := monitor.New(config, backend)
 
 	wg := sync.WaitGroup{}
-	wg.Add(len(feedConf.Feeds) + 1)
+	if feedConf.Listen != "" {
+		wg.Add(1)
+		go serveAPI(termCtx, feedConf.Listen, mon, hub, &wg)
+	}
+	if feedConf.GRPCListen != "" {
+		wg.Add(1)
+		go serveGRPC(termCtx, feedConf.GRPCListen, mon, hub, &wg)
+	}
 
-	go subscribeBlocks(termCtx, client, &wg)
-	for _, feed := range feedConf.Feeds {
-		go subscribeEvents(termCtx, client, feed.Address, feed.Tokens, &wg)
+	go mon.Run(termCtx)
+	if replay != nil {
+		go func() {
+			err := replay.Play(termCtx, *replaySpeed)
+			if err != nil && !errors.Is(err, context.Canceled) {
+				log.Errorf("Failed replaying capture: %s", err)
+			}
+			// Let the monitor process the last entries before stopping it
+			select {
+			case <-time.After(replayDrainDelay):
+			case <-termCtx.Done():
+			}
+			log.Info("Replay finished")
+			termCancel()
+		}()
+	}
+	for ev := range mon.Events() {
+		recordMetrics(ev)
+		sinks.Send(sink.NewRecord(time.Now(), network, ev))
 	}
 
+	termCancel()
 	wg.Wait()
 }
@@ -0,0 +1,53 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+func TestShippedFeedConfig(t *testing.T) {
+	feedConf, err := parseFeedConfig("feed.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	config := monitorConfig(feedConf)
+	if len(config.Feeds) != len(feedConf.Feeds) {
+		t.Errorf("expected %d feeds, resolved %d", len(feedConf.Feeds), len(config.Feeds))
+	}
+	if len(config.DivergenceGroups) != len(feedConf.Groups) {
+		t.Errorf("expected %d divergence groups, resolved %d", len(feedConf.Groups), len(config.DivergenceGroups))
+	}
+	if len(config.Contracts) != len(feedConf.Contracts) {
+		t.Errorf("expected %d contracts, resolved %d", len(feedConf.Contracts), len(config.Contracts))
+	}
+}
+
+func TestNetworkGroupMembers(t *testing.T) {
+	mainnet := "0x5f4eC3Df9cbd43714FE2740f5E3616155c5b8419"
+	arbitrum := "0x639Fe6ab55C921f74e7fac1ee960C0B6293ba612"
+	feedConf := &feedConfig{
+		Feeds: []feedData{{Tokens: "ETH / USD", Address: mainnet}},
+		Networks: []networkData{{
+			Name:  "arbitrum",
+			Feeds: []feedData{{Tokens: "ETH / USD", Address: arbitrum}, {Tokens: "BTC / USD", Address: "btc-usd.data.eth"}},
+		}},
+		Groups: []groupData{
+			{Name: "ETH / USD", Members: []memberData{{Feed: "ETH / USD"}, {Feed: "ETH / USD", Network: "arbitrum"}}},
+			{Name: "BTC / USD", Members: []memberData{{Feed: "ETH / USD"}, {Feed: "BTC / USD", Network: "arbitrum"}}},
+			{Name: "Optimism", Members: []memberData{{Feed: "ETH / USD"}, {Feed: "ETH / USD", Network: "optimism"}}},
+		},
+	}
+
+	config := monitorConfig(feedConf)
+	if len(config.DivergenceGroups) != 1 {
+		t.Fatalf("expected only the group with feeds on configured networks, got %d groups", len(config.DivergenceGroups))
+	}
+	members := config.DivergenceGroups[0].Members
+	if members[0].Network != "" || members[0].Feed.Address != common.HexToAddress(mainnet) {
+		t.Errorf("expected mainnet member at %s, got %s on %q", mainnet, members[0].Feed.Address, members[0].Network)
+	}
+	if members[1].Network != "arbitrum" || members[1].Feed.Address != common.HexToAddress(arbitrum) {
+		t.Errorf("expected arbitrum member at %s, got %s on %q", arbitrum, members[1].Feed.Address, members[1].Network)
+	}
+}
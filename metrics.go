@@ -0,0 +1,219 @@
+package main
+
+import (
+	"math/big"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"hw-3/monitor"
+)
+
+const (
+	metricsNamespace = "monitor"
+)
+
+var (
+	gasBaseFeeGauge = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Subsystem: "gas",
+		Name:      "base_fee_wei",
+		Help:      "Base fee of the latest block.",
+	})
+	gasNextBaseFeeGauge = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Subsystem: "gas",
+		Name:      "next_base_fee_wei",
+		Help:      "Predicted base fee of the next block.",
+	})
+	gasPriorityFeeGauge = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Subsystem: "gas",
+		Name:      "max_priority_fee_wei",
+		Help:      "Suggested max priority fee per gas.",
+	}, []string{"speed"})
+	gasMaxFeeGauge = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Subsystem: "gas",
+		Name:      "max_fee_wei",
+		Help:      "Suggested max fee per gas.",
+	}, []string{"speed"})
+	governanceAlertsCounter = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Subsystem: "governance",
+		Name:      "alerts_total",
+		Help:      "Governance changes observed on feed proxies.",
+	}, []string{"feed", "kind"})
+	shadowDeviationGauge = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Subsystem: "governance",
+		Name:      "proposed_deviation_percent",
+		Help:      "Deviation of the proposed aggregator answer from the current one.",
+	}, []string{"feed"})
+	dexDeviationGauge = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Subsystem: "dex",
+		Name:      "deviation_percent",
+		Help:      "Deviation of the DEX spot price from the latest feed answer.",
+	}, []string{"feed", "pool"})
+	divergedGauge = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Subsystem: "divergence",
+		Name:      "diverged",
+		Help:      "Whether the prices of a divergence group are apart for longer than its duration.",
+	}, []string{"group"})
+	ocrTransmissionsCounter = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Subsystem: "ocr",
+		Name:      "transmissions_total",
+		Help:      "OCR reports transmitted, by the oracle that transmitted them.",
+	}, []string{"feed", "transmitter"})
+	ocrObserversGauge = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Subsystem: "ocr",
+		Name:      "observers",
+		Help:      "Number of observations in the latest OCR report.",
+	}, []string{"feed"})
+	ocrSpreadGauge = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Subsystem: "ocr",
+		Name:      "spread_percent",
+		Help:      "Spread of the observations in the latest OCR report relative to their median.",
+	}, []string{"feed"})
+	pendingPricesCounter = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Subsystem: "ocr",
+		Name:      "pending_transmissions_total",
+		Help:      "OCR transmissions seen in the mempool before being mined.",
+	}, []string{"feed"})
+	sequencerUpGauge = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Subsystem: "sequencer",
+		Name:      "up",
+		Help:      "Whether the L2 sequencer uptime feed reports the sequencer as up.",
+	})
+	unreliablePricesCounter = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Subsystem: "sequencer",
+		Name:      "unreliable_prices_total",
+		Help:      "Price updates received while the sequencer was down or in its grace period.",
+	}, []string{"feed"})
+	blockLatencyHistogram = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: metricsNamespace,
+		Subsystem: "latency",
+		Name:      "block_seconds",
+		Help:      "Time from the updatedAt of a round to the block including its price update.",
+		Buckets:   prometheus.ExponentialBuckets(1, 2, 12),
+	}, []string{"feed"})
+	receiveLatencyHistogram = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: metricsNamespace,
+		Subsystem: "latency",
+		Name:      "receive_seconds",
+		Help:      "Time from the block including a price update to the monitor receiving it.",
+		Buckets:   prometheus.ExponentialBuckets(0.25, 2, 10),
+	}, []string{"feed"})
+	updateCostCounter = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Subsystem: "updates",
+		Name:      "cost_eth_total",
+		Help:      "ETH paid for the transactions delivering price updates.",
+	}, []string{"feed"})
+	updateGasCounter = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Subsystem: "updates",
+		Name:      "gas_used_total",
+		Help:      "Gas used by the transactions delivering price updates.",
+	}, []string{"feed"})
+	roundsStartedCounter = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Subsystem: "rounds",
+		Name:      "started_total",
+		Help:      "Rounds started, by the oracle that started them.",
+	}, []string{"feed", "oracle"})
+	roundsUnansweredCounter = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Subsystem: "rounds",
+		Name:      "unanswered_total",
+		Help:      "Rounds that were started but never answered.",
+	}, []string{"feed"})
+	roundDurationHistogram = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: metricsNamespace,
+		Subsystem: "rounds",
+		Name:      "duration_seconds",
+		Help:      "Time from the start of a round to its answer.",
+		Buckets:   prometheus.ExponentialBuckets(1, 2, 12),
+	}, []string{"feed"})
+)
+
+func bigToFloat(value *big.Int) float64 {
+	f, _ := new(big.Float).SetInt(value).Float64()
+	return f
+}
+
+// feedLabel identifies a feed in metrics by its proxy address. Feeds resolved
+// through the Feed Registry share its address, so their tokens are used.
+func feedLabel(feed monitor.Feed) string {
+	if feed.Registry() {
+		return feed.Tokens
+	}
+	return feed.Address.Hex()
+}
+
+func reportGasEstimate(estimate *monitor.GasEstimate) {
+	gasBaseFeeGauge.Set(bigToFloat(estimate.BaseFee))
+	gasNextBaseFeeGauge.Set(bigToFloat(estimate.NextBaseFee))
+	for speed, suggestion := range estimate.Suggestions {
+		gasPriorityFeeGauge.WithLabelValues(speed).Set(bigToFloat(suggestion.MaxPriorityFee))
+		gasMaxFeeGauge.WithLabelValues(speed).Set(bigToFloat(suggestion.MaxFee))
+	}
+}
+
+func recordMetrics(ev monitor.Event) {
+	switch ev := ev.(type) {
+	case *monitor.GasUpdate:
+		reportGasEstimate(ev.Estimate)
+	case *monitor.PriceUpdate:
+		if ev.Unreliable {
+			unreliablePricesCounter.WithLabelValues(feedLabel(ev.Feed)).Inc()
+		}
+		if !ev.BlockTime.IsZero() {
+			blockLatencyHistogram.WithLabelValues(feedLabel(ev.Feed)).Observe(ev.BlockLatency().Seconds())
+			receiveLatencyHistogram.WithLabelValues(feedLabel(ev.Feed)).Observe(ev.ReceiveLatency().Seconds())
+		}
+	case *monitor.UpdateCost:
+		updateCostCounter.WithLabelValues(feedLabel(ev.Feed)).Add(ev.Cost.Float64())
+		updateGasCounter.WithLabelValues(feedLabel(ev.Feed)).Add(float64(ev.GasUsed))
+	case *monitor.Transmission:
+		ocrTransmissionsCounter.WithLabelValues(feedLabel(ev.Feed), ev.Transmitter.Hex()).Inc()
+		ocrObserversGauge.WithLabelValues(feedLabel(ev.Feed)).Set(float64(len(ev.Observers)))
+		ocrSpreadGauge.WithLabelValues(feedLabel(ev.Feed)).Set(ev.SpreadPercent)
+	case *monitor.PendingPrice:
+		pendingPricesCounter.WithLabelValues(feedLabel(ev.Feed)).Inc()
+	case *monitor.SequencerStatus:
+		if ev.Up {
+			sequencerUpGauge.Set(1)
+		} else {
+			sequencerUpGauge.Set(0)
+		}
+	case *monitor.Divergence:
+		if ev.Diverged {
+			divergedGauge.WithLabelValues(ev.Group).Set(1)
+		} else {
+			divergedGauge.WithLabelValues(ev.Group).Set(0)
+		}
+	case *monitor.RoundStarted:
+		roundsStartedCounter.WithLabelValues(feedLabel(ev.Feed), ev.StartedBy.Hex()).Inc()
+	case *monitor.RoundAnswered:
+		roundDurationHistogram.WithLabelValues(feedLabel(ev.Feed)).Observe(ev.Duration.Seconds())
+	case *monitor.RoundUnanswered:
+		roundsUnansweredCounter.WithLabelValues(feedLabel(ev.Feed)).Inc()
+	case *monitor.GovernanceAlert:
+		governanceAlertsCounter.WithLabelValues(feedLabel(ev.Feed), string(ev.Kind)).Inc()
+		if ev.Kind == monitor.AggregatorConfirmed || ev.Kind == monitor.ProposalWithdrawn {
+			shadowDeviationGauge.DeleteLabelValues(feedLabel(ev.Feed))
+		}
+	case *monitor.ProposedDeviation:
+		shadowDeviationGauge.WithLabelValues(feedLabel(ev.Feed)).Set(ev.DeviationPercent)
+	case *monitor.DEXDeviation:
+		dexDeviationGauge.WithLabelValues(feedLabel(ev.Feed), ev.Pool.Hex()).Set(ev.DeviationPercent)
+	}
+}
@@ -0,0 +1,133 @@
+package monitor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"sync"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+var errNoEvents = errors.New("ABI has no events")
+
+// abiEventNames returns the sorted names of the events of contractABI that
+// can be filtered by their ID.
+func abiEventNames(contractABI abi.ABI) []string {
+	names := make([]string, 0, len(contractABI.Events))
+	for name, abiEvent := range contractABI.Events {
+		if !abiEvent.Anonymous {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+func formatABIValue(value interface{}) string {
+	switch v := value.(type) {
+	case common.Address:
+		return v.Hex()
+	case common.Hash:
+		return v.Hex()
+	case *big.Int:
+		return v.String()
+	case []byte:
+		return hexutil.Encode(v)
+	case [32]byte:
+		return hexutil.Encode(v[:])
+	default:
+		return fmt.Sprintf("%v", v)
+	}
+}
+
+func (m *Monitor) contractError(ctx context.Context, contract Contract, msg string, err error) {
+	m.emit(ctx, &ContractError{Contract: contract.Name, Address: contract.Address, Message: msg, Err: err})
+}
+
+func (m *Monitor) subscribeContractEvents(ctx context.Context, contract Contract, wg *sync.WaitGroup) {
+	defer wg.Done()
+
+	if len(contract.Events) == 0 {
+		contract.Events = abiEventNames(contract.ABI)
+	}
+	if len(contract.Events) == 0 {
+		m.contractError(ctx, contract, "Failed resolving events", errNoEvents)
+		return
+	}
+	eventIDs := make([]common.Hash, 0, len(contract.Events))
+	for _, eventName := range contract.Events {
+		abiEvent, ok := contract.ABI.Events[eventName]
+		if !ok {
+			m.contractError(ctx, contract, "Failed resolving event", fmt.Errorf("no event %s in ABI", eventName))
+			return
+		}
+		eventIDs = append(eventIDs, abiEvent.ID)
+	}
+
+	boundContract := bind.NewBoundContract(contract.Address, contract.ABI, m.backend, m.backend, m.backend)
+
+	logs := make(chan types.Log)
+	query := ethereum.FilterQuery{
+		Addresses: []common.Address{contract.Address},
+		Topics:    [][]common.Hash{eventIDs},
+	}
+	sub, err := m.backend.SubscribeFilterLogs(ctx, query, logs)
+	if err != nil {
+		m.contractError(ctx, contract, "Failed subscribing to contract events", err)
+		return
+	}
+	resub := resubscribe(sub,
+		func(subCtx context.Context) (ethereum.Subscription, error) {
+			return m.backend.SubscribeFilterLogs(subCtx, query, logs)
+		},
+		func(err error) { m.contractError(ctx, contract, "Failed while listening for events", err) },
+		func(err error) { m.contractError(ctx, contract, "Failed resubscribing to contract events", err) })
+	defer resub.Unsubscribe()
+
+	m.emit(ctx, &Subscribed{
+		Kind:    SubscriptionContract,
+		Name:    contract.Name,
+		Address: contract.Address,
+		Events:  contract.Events,
+	})
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case vLog := <-logs:
+			if len(vLog.Topics) == 0 {
+				continue
+			}
+			abiEvent, err := contract.ABI.EventByID(vLog.Topics[0])
+			if err != nil {
+				m.contractError(ctx, contract, "Failed resolving event", err)
+				continue
+			}
+
+			decoded := make(map[string]interface{})
+			if err := boundContract.UnpackLogIntoMap(decoded, abiEvent.Name, vLog); err != nil {
+				m.contractError(ctx, contract, "Failed decoding event", err)
+				continue
+			}
+			args := make(map[string]string, len(decoded))
+			for name, value := range decoded {
+				args[name] = formatABIValue(value)
+			}
+
+			m.emit(ctx, &ContractEvent{
+				Contract: contract.Name,
+				Event:    abiEvent.Name,
+				Args:     args,
+				Log:      vLog,
+			})
+		}
+	}
+}
@@ -0,0 +1,117 @@
+package monitor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"hw-3/decimal"
+)
+
+const (
+	// etherDecimals is the number of decimals of ETH amounts in wei.
+	etherDecimals = 18
+	// updateCostQueueSize is the number of price updates waiting for their
+	// cost to be looked up.
+	updateCostQueueSize = 256
+)
+
+var (
+	errNoTransactionBackend = errors.New("backend doesn't support looking up transactions")
+	errUpdateCostsBehind    = errors.New("too many updates waiting for their cost")
+)
+
+// TransactionBackend is implemented by backends able to look up transactions
+// and their receipts, e.g. *ethclient.Client or the simulated backend.
+type TransactionBackend interface {
+	TransactionByHash(ctx context.Context, hash common.Hash) (*types.Transaction, bool, error)
+	TransactionReceipt(ctx context.Context, hash common.Hash) (*types.Receipt, error)
+}
+
+// effectiveGasPrice is the price per gas paid by tx included in a block with
+// the given base fee, which is nil before London.
+func effectiveGasPrice(tx *types.Transaction, baseFee *big.Int) *big.Int {
+	if baseFee == nil {
+		return tx.GasPrice()
+	}
+	return new(big.Int).Add(baseFee, tx.EffectiveGasTipValue(baseFee))
+}
+
+// updateCost looks up the transaction that delivered update and what it cost.
+func (m *Monitor) updateCost(ctx context.Context, update *PriceUpdate) (*UpdateCost, error) {
+	txBackend, ok := m.backend.(TransactionBackend)
+	if !ok {
+		return nil, errNoTransactionBackend
+	}
+	timeoutCtx, cancel := context.WithTimeout(ctx, m.config.QueryTimeout)
+	defer cancel()
+
+	txHash := update.Log.TxHash
+	tx, _, err := txBackend.TransactionByHash(timeoutCtx, txHash)
+	if err != nil {
+		return nil, fmt.Errorf("failed querying transaction %s: %w", txHash, err)
+	}
+	receipt, err := txBackend.TransactionReceipt(timeoutCtx, txHash)
+	if err != nil {
+		return nil, fmt.Errorf("failed querying receipt of %s: %w", txHash, err)
+	}
+	header, err := m.blockHeader(timeoutCtx, receipt.BlockHash, receipt.BlockNumber.Uint64())
+	if err != nil {
+		return nil, fmt.Errorf("failed querying block %s: %w", receipt.BlockNumber, err)
+	}
+	transmitter, err := types.Sender(types.LatestSignerForChainID(tx.ChainId()), tx)
+	if err != nil {
+		return nil, fmt.Errorf("failed recovering sender of %s: %w", txHash, err)
+	}
+
+	gasPrice := effectiveGasPrice(tx, header.BaseFee)
+	cost := new(big.Int).Mul(gasPrice, new(big.Int).SetUint64(receipt.GasUsed))
+	return &UpdateCost{
+		Feed:              update.Feed,
+		RoundID:           update.RoundID,
+		TxHash:            txHash,
+		Transmitter:       transmitter,
+		GasUsed:           receipt.GasUsed,
+		EffectiveGasPrice: gasPrice,
+		Cost:              decimal.New(cost, etherDecimals),
+	}, nil
+}
+
+// queueUpdateCost has the cost of update looked up by watchUpdateCosts, so
+// that a slow backend doesn't hold back the events of the feed. Updates are
+// dropped while the queue is full.
+func (m *Monitor) queueUpdateCost(ctx context.Context, update *PriceUpdate) {
+	select {
+	case m.costs <- update:
+	default:
+		m.feedError(ctx, update.Feed, "Failed querying update cost", errUpdateCostsBehind)
+	}
+}
+
+// watchUpdateCosts looks up the cost of the queued price updates, there is no
+// queue if the backend can't look up transactions.
+func (m *Monitor) watchUpdateCosts(ctx context.Context, wg *sync.WaitGroup) {
+	defer wg.Done()
+
+	if m.costs == nil {
+		m.emit(ctx, &BlockError{Message: "Failed looking up update costs", Err: errNoTransactionBackend})
+		return
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case update := <-m.costs:
+			cost, err := m.updateCost(ctx, update)
+			if err != nil {
+				m.feedError(ctx, update.Feed, "Failed querying update cost", err)
+				continue
+			}
+			m.emit(ctx, cost)
+		}
+	}
+}
@@ -0,0 +1,80 @@
+package monitor_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind/backends"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"hw-3/monitor"
+)
+
+// stalledTxBackend holds transaction lookups until release is closed.
+type stalledTxBackend struct {
+	*backends.SimulatedBackend
+	release chan struct{}
+}
+
+func (b *stalledTxBackend) TransactionByHash(ctx context.Context, hash common.Hash) (*types.Transaction, bool, error) {
+	select {
+	case <-b.release:
+	case <-ctx.Done():
+		return nil, false, ctx.Err()
+	}
+	return b.SimulatedBackend.TransactionByHash(ctx, hash)
+}
+
+func TestUpdateCost(t *testing.T) {
+	chain := newChain(t)
+	feed := deployFeed(t, chain, 100_00000000)
+	events := startMonitor(t, chain.Backend, monitor.Config{
+		Feeds:       []monitor.Feed{{Tokens: "ETH / USD", Address: feed.Address}},
+		UpdateCosts: true,
+	})
+
+	price := pushPrice(t, chain, feed, events, 101_00000000)
+	cost := nextEvent[*monitor.UpdateCost](t, events)
+	if cost.TxHash != price.Log.TxHash || cost.RoundID.Cmp(price.RoundID) != 0 {
+		t.Errorf("expected cost of round %s in %s, got round %s in %s", price.RoundID, price.Log.TxHash, cost.RoundID, cost.TxHash)
+	}
+	if cost.Transmitter != chain.Oracles[0].From {
+		t.Errorf("expected transmitter %s, got %s", chain.Oracles[0].From, cost.Transmitter)
+	}
+
+	receipt, err := chain.Backend.TransactionReceipt(context.Background(), price.Log.TxHash)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cost.GasUsed != receipt.GasUsed {
+		t.Errorf("expected %d gas used, got %d", receipt.GasUsed, cost.GasUsed)
+	}
+	if cost.EffectiveGasPrice.Sign() <= 0 {
+		t.Errorf("expected positive gas price, got %s", cost.EffectiveGasPrice)
+	}
+	wei := new(big.Int).Mul(cost.EffectiveGasPrice, new(big.Int).SetUint64(receipt.GasUsed))
+	if cost.Cost.Unscaled().Cmp(wei) != 0 || cost.Cost.Scale() != 18 {
+		t.Errorf("expected cost of %s wei, got %s ETH", wei, cost.Cost)
+	}
+}
+
+func TestUpdateCostDoesNotHoldBackUpdates(t *testing.T) {
+	chain := newChain(t)
+	feed := deployFeed(t, chain, 100_00000000)
+	backend := &stalledTxBackend{SimulatedBackend: chain.Backend, release: make(chan struct{})}
+	events := startMonitor(t, backend, monitor.Config{
+		Feeds:       []monitor.Feed{{Tokens: "ETH / USD", Address: feed.Address}},
+		UpdateCosts: true,
+	})
+
+	first := pushPrice(t, chain, feed, events, 101_00000000)
+	second := pushPrice(t, chain, feed, events, 102_00000000)
+	close(backend.release)
+	for _, price := range []*monitor.PriceUpdate{first, second} {
+		cost := nextEvent[*monitor.UpdateCost](t, events)
+		if cost.TxHash != price.Log.TxHash {
+			t.Errorf("expected cost of %s, got %s", price.Log.TxHash, cost.TxHash)
+		}
+	}
+}
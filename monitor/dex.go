@@ -0,0 +1,236 @@
+package monitor
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"hw-3/decimal"
+	"hw-3/erc20"
+	"hw-3/uniswap"
+)
+
+const (
+	// dexPriceScale is the number of fractional digits of DEX spot prices.
+	dexPriceScale = 18
+	// dexHeadersBufSize is how many new blocks a DEX pool can fall behind
+	// before it holds up subscribeBlocks.
+	dexHeadersBufSize = 16
+)
+
+// DEXKind tells which protocol a DEX pool belongs to.
+type DEXKind string
+
+const (
+	UniswapV2 DEXKind = "uniswapV2"
+	UniswapV3 DEXKind = "uniswapV3"
+)
+
+// DEXPool is a Uniswap V2 pair or V3 pool whose spot price is compared with
+// the answer of Feed every block. Pools price token0 in token1, Inverse
+// compares the price of token1 in token0 instead.
+type DEXPool struct {
+	Feed    Feed
+	Kind    DEXKind
+	Address common.Address
+	Inverse bool
+}
+
+var q192 = new(big.Int).Lsh(big.NewInt(1), 192)
+
+// spotRatio returns the price of token0 in token1 as a fraction of raw token
+// amounts.
+type spotRatio func(opts *bind.CallOpts) (numerator, denominator *big.Int, err error)
+
+func newSpotRatio(pool DEXPool, backend bind.ContractCaller) (spotRatio, error) {
+	switch pool.Kind {
+	case UniswapV2:
+		pair, err := uniswap.NewV2PairCaller(pool.Address, backend)
+		if err != nil {
+			return nil, err
+		}
+		return func(opts *bind.CallOpts) (*big.Int, *big.Int, error) {
+			reserves, err := pair.GetReserves(opts)
+			if err != nil {
+				return nil, nil, err
+			}
+			return reserves.Reserve1, reserves.Reserve0, nil
+		}, nil
+	case UniswapV3:
+		v3Pool, err := uniswap.NewV3PoolCaller(pool.Address, backend)
+		if err != nil {
+			return nil, err
+		}
+		return func(opts *bind.CallOpts) (*big.Int, *big.Int, error) {
+			slot0, err := v3Pool.Slot0(opts)
+			if err != nil {
+				return nil, nil, err
+			}
+			// sqrtPriceX96 is the square root of the price as a Q64.96 number
+			return new(big.Int).Mul(slot0.SqrtPriceX96, slot0.SqrtPriceX96), q192, nil
+		}, nil
+	default:
+		return nil, fmt.Errorf("unknown DEX kind %q", pool.Kind)
+	}
+}
+
+// poolTokens queries the tokens of a pool, which have the same getters on
+// both Uniswap versions.
+func poolTokens(opts *bind.CallOpts, address common.Address, backend bind.ContractCaller) (token0, token1 common.Address, err error) {
+	pair, err := uniswap.NewV2PairCaller(address, backend)
+	if err != nil {
+		return common.Address{}, common.Address{}, err
+	}
+	if token0, err = pair.Token0(opts); err != nil {
+		return common.Address{}, common.Address{}, fmt.Errorf("failed querying token0: %w", err)
+	}
+	if token1, err = pair.Token1(opts); err != nil {
+		return common.Address{}, common.Address{}, fmt.Errorf("failed querying token1: %w", err)
+	}
+	return token0, token1, nil
+}
+
+func tokenDecimals(opts *bind.CallOpts, address common.Address, backend bind.ContractCaller) (uint8, error) {
+	token, err := erc20.NewERC20Caller(address, backend)
+	if err != nil {
+		return 0, err
+	}
+	decimals, err := token.Decimals(opts)
+	if err != nil {
+		return 0, fmt.Errorf("failed querying decimals of %s: %w", address, err)
+	}
+	return decimals, nil
+}
+
+// dexPricer computes the spot price of a pool adjusted for the decimals of
+// its tokens.
+type dexPricer struct {
+	pool      DEXPool
+	ratio     spotRatio
+	decimals0 uint8
+	decimals1 uint8
+}
+
+func (m *Monitor) newDEXPricer(ctx context.Context, pool DEXPool) (*dexPricer, error) {
+	ratio, err := newSpotRatio(pool, m.backend)
+	if err != nil {
+		return nil, err
+	}
+	opts, cancel := m.callOpts(ctx)
+	defer cancel()
+	token0, token1, err := poolTokens(opts, pool.Address, m.backend)
+	if err != nil {
+		return nil, err
+	}
+	pricer := &dexPricer{pool: pool, ratio: ratio}
+	if pricer.decimals0, err = tokenDecimals(opts, token0, m.backend); err != nil {
+		return nil, err
+	}
+	if pricer.decimals1, err = tokenDecimals(opts, token1, m.backend); err != nil {
+		return nil, err
+	}
+	return pricer, nil
+}
+
+func (p *dexPricer) price(opts *bind.CallOpts) (decimal.Decimal, error) {
+	numerator, denominator, err := p.ratio(opts)
+	if err != nil {
+		return decimal.Decimal{}, err
+	}
+	// Amounts of token0 are scaled by decimals0 and amounts of token1 by decimals1
+	price := decimal.New(numerator, p.decimals1)
+	amount := decimal.New(denominator, p.decimals0)
+	if p.pool.Inverse {
+		price, amount = amount, price
+	}
+	return price.Quo(amount, dexPriceScale)
+}
+
+// chainlinkPrice returns the price of the last update observed for feed and
+// queries the latest round if none was observed yet.
+func (m *Monitor) chainlinkPrice(ctx context.Context, feed Feed) (decimal.Decimal, *big.Int, error) {
+	if update := m.latest.get(m.names.resolve(feed)); update != nil {
+		return update.Price, update.RoundID, nil
+	}
+	round, err := m.Round(ctx, feed, nil)
+	if err != nil {
+		return decimal.Decimal{}, nil, err
+	}
+	return round.Price, round.RoundID, nil
+}
+
+func (m *Monitor) compareDEXPrice(ctx context.Context, pricer *dexPricer, header *types.Header) (*DEXDeviation, error) {
+	opts, cancel := m.callOpts(ctx)
+	opts.BlockNumber = header.Number
+	dexPrice, err := pricer.price(opts)
+	cancel()
+	if err != nil {
+		return nil, fmt.Errorf("failed querying DEX price: %w", err)
+	}
+	chainlinkPrice, roundID, err := m.chainlinkPrice(ctx, pricer.pool.Feed)
+	if err != nil {
+		return nil, fmt.Errorf("failed querying Chainlink price: %w", err)
+	}
+	if chainlinkPrice.Sign() == 0 {
+		return nil, errZeroAnswer
+	}
+	deviation, err := dexPrice.Sub(chainlinkPrice).Quo(chainlinkPrice, deviationScale)
+	if err != nil {
+		return nil, err
+	}
+	return &DEXDeviation{
+		Feed:             pricer.pool.Feed,
+		Pool:             pricer.pool.Address,
+		Kind:             pricer.pool.Kind,
+		Block:            header.Number.Uint64(),
+		DEXPrice:         dexPrice,
+		ChainlinkPrice:   chainlinkPrice,
+		ChainlinkRoundID: roundID,
+		DeviationPercent: deviation.Float64() * 100,
+		Unreliable:       m.sequencer.unreliable(time.Unix(int64(header.Time), 0)),
+	}, nil
+}
+
+// watchDEXPool compares the spot price of pool with its feed on every new
+// block received by subscribeBlocks.
+func (m *Monitor) watchDEXPool(ctx context.Context, pool DEXPool, wg *sync.WaitGroup) {
+	defer wg.Done()
+
+	if _, ok := m.backend.(BlockBackend); !ok {
+		m.feedError(ctx, pool.Feed, "Failed subscribing to blocks for DEX prices", errNoBlockBackend)
+		return
+	}
+	pricer, err := m.newDEXPricer(ctx, pool)
+	if err != nil {
+		m.feedError(ctx, pool.Feed, "Failed acquiring DEX pool", err)
+		return
+	}
+
+	headers := make(chan *types.Header, dexHeadersBufSize)
+	sub := m.heads.Subscribe(headers)
+	defer sub.Unsubscribe()
+
+	m.emit(ctx, &Subscribed{
+		Kind:    SubscriptionDEX,
+		Name:    pool.Feed.Tokens,
+		Address: pool.Address,
+	})
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case header := <-headers:
+			deviation, err := m.compareDEXPrice(ctx, pricer, header)
+			if err != nil {
+				m.feedError(ctx, pool.Feed, "Failed comparing DEX price", err)
+				continue
+			}
+			m.emit(ctx, deviation)
+		}
+	}
+}
@@ -0,0 +1,133 @@
+package monitor_test
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"hw-3/erc20"
+	"hw-3/fakenode"
+	"hw-3/monitor"
+	"hw-3/proxy"
+	"hw-3/uniswap"
+)
+
+var (
+	wethAddress   = common.HexToAddress("0xC02aaA39b223FE8D0A0e5C4F27eAD9083C756Cc2")
+	usdcAddress   = common.HexToAddress("0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48")
+	v2PairAddress = common.HexToAddress("0xB4e16d0168e52d35CaCD2c6185b44281Ec28C9Dc")
+	v3PoolAddress = common.HexToAddress("0x88e6A0c2dDD26FEEb64F039a2c41296FcB3f5640")
+)
+
+// dexNode starts a fake node whose feed answers 2000 and whose pools quote
+// WETH at 2020 USDC on Uniswap V2 and at 2500 USDC on Uniswap V3.
+func dexNode(t *testing.T) *fakenode.Node {
+	t.Helper()
+	node := feedNode(t)
+	proxyABI := parseABI(t, proxy.ProxyMetaData.ABI)
+	pairABI := parseABI(t, uniswap.V2PairMetaData.ABI)
+	poolABI := parseABI(t, uniswap.V3PoolMetaData.ABI)
+	tokenABI := parseABI(t, erc20.ERC20MetaData.ABI)
+	roundID := big.NewInt(1)
+	ether := new(big.Int).Exp(big.NewInt(10), big.NewInt(18), nil)
+	// USDC is token0 of the V3 pool, 1 USDC is worth 1/2500 WETH there
+	sqrtPriceX96 := new(big.Int).Lsh(big.NewInt(20000), 96)
+	for _, err := range []error{
+		node.SetCallResult(feedAddress, proxyABI, "decimals", uint8(8)),
+		node.SetCallResult(feedAddress, proxyABI, "latestRoundData",
+			roundID, big.NewInt(2000_00000000), big.NewInt(1000), big.NewInt(1000), roundID),
+		node.SetCallResult(wethAddress, tokenABI, "decimals", uint8(18)),
+		node.SetCallResult(usdcAddress, tokenABI, "decimals", uint8(6)),
+		node.SetCallResult(v2PairAddress, pairABI, "token0", wethAddress),
+		node.SetCallResult(v2PairAddress, pairABI, "token1", usdcAddress),
+		node.SetCallResult(v2PairAddress, pairABI, "getReserves",
+			new(big.Int).Mul(big.NewInt(10), ether), big.NewInt(20200_000000), uint32(1000)),
+		node.SetCallResult(v3PoolAddress, poolABI, "token0", usdcAddress),
+		node.SetCallResult(v3PoolAddress, poolABI, "token1", wethAddress),
+		node.SetCallResult(v3PoolAddress, poolABI, "slot0",
+			sqrtPriceX96, big.NewInt(0), uint16(0), uint16(1), uint16(1), uint8(0), true),
+	} {
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	return node
+}
+
+func TestDEXDeviation(t *testing.T) {
+	node := dexNode(t)
+	feed := monitor.Feed{Tokens: "ETH / USD", Address: feedAddress}
+	events := startMonitor(t, dialNode(t, node), monitor.Config{
+		Feeds: []monitor.Feed{feed},
+		DEXPools: []monitor.DEXPool{
+			{Feed: feed, Kind: monitor.UniswapV2, Address: v2PairAddress},
+			{Feed: feed, Kind: monitor.UniswapV3, Address: v3PoolAddress, Inverse: true},
+		},
+	})
+
+	block := node.Mine()
+	deviations := make(map[common.Address]*monitor.DEXDeviation)
+	for len(deviations) < 2 {
+		deviation := nextEvent[*monitor.DEXDeviation](t, events)
+		deviations[deviation.Pool] = deviation
+	}
+	for pool, expected := range map[common.Address]struct {
+		price     string
+		deviation float64
+	}{
+		v2PairAddress: {"2020.000000000000000000", 1},
+		v3PoolAddress: {"2500.000000000000000000", 25},
+	} {
+		deviation := deviations[pool]
+		if deviation.Block != block.Number.Uint64() {
+			t.Errorf("expected price of %s at block %s, got %d", pool, block.Number, deviation.Block)
+		}
+		if got := deviation.DEXPrice.String(); got != expected.price {
+			t.Errorf("expected DEX price %s of %s, got %s", expected.price, pool, got)
+		}
+		if got := deviation.ChainlinkPrice.String(); got != "2000.00000000" {
+			t.Errorf("expected Chainlink price 2000.00000000, got %s", got)
+		}
+		if deviation.DeviationPercent != expected.deviation {
+			t.Errorf("expected %f%% deviation of %s, got %f", expected.deviation, pool, deviation.DeviationPercent)
+		}
+		if deviation.Unreliable {
+			t.Errorf("expected deviation of %s to be reliable without a sequencer uptime feed", pool)
+		}
+	}
+}
+
+func TestDEXDeviationSequencerDown(t *testing.T) {
+	node := dexNode(t)
+	setSequencer(t, node, false, time.Now().Add(-time.Hour))
+	feed := monitor.Feed{Tokens: "ETH / USD", Address: feedAddress}
+	events := startMonitor(t, dialNode(t, node), monitor.Config{
+		Feeds:               []monitor.Feed{feed},
+		DEXPools:            []monitor.DEXPool{{Feed: feed, Kind: monitor.UniswapV2, Address: v2PairAddress}},
+		SequencerUptimeFeed: uptimeAddress,
+	})
+
+	node.Mine()
+	if deviation := nextEvent[*monitor.DEXDeviation](t, events); !deviation.Unreliable {
+		t.Error("expected deviation while the sequencer is down to be unreliable")
+	}
+}
+
+func TestDEXPoolsShareBlocks(t *testing.T) {
+	feed := monitor.Feed{Tokens: "ETH / USD", Address: feedAddress}
+	withoutPools := dexNode(t)
+	startMonitor(t, dialNode(t, withoutPools), monitor.Config{Feeds: []monitor.Feed{feed}})
+	node := dexNode(t)
+	startMonitor(t, dialNode(t, node), monitor.Config{
+		Feeds: []monitor.Feed{feed},
+		DEXPools: []monitor.DEXPool{
+			{Feed: feed, Kind: monitor.UniswapV2, Address: v2PairAddress},
+			{Feed: feed, Kind: monitor.UniswapV3, Address: v3PoolAddress, Inverse: true},
+		},
+	})
+
+	if got, expected := node.Subscriptions(), withoutPools.Subscriptions(); got != expected {
+		t.Errorf("expected DEX pools to share the block subscription, got %d subscriptions instead of %d", got, expected)
+	}
+}
@@ -0,0 +1,200 @@
+package monitor
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"hw-3/decimal"
+)
+
+// groupPriceScale is the number of fractional digits of synthetic prices.
+const groupPriceScale = 18
+
+// GroupMember is a feed of a divergence group. Its price is multiplied by the
+// prices of the Via feeds, e.g. ETH / BTC via BTC / USD tracks ETH / USD.
+// Network names the entry of Config.Networks all feeds of the member are read
+// from, they are on the monitored network if it is empty.
+type GroupMember struct {
+	Feed    Feed
+	Via     []Feed
+	Network string
+}
+
+// Name describes the member by the tokens of its feeds and its network.
+func (g GroupMember) Name() string {
+	tokens := []string{g.Feed.Tokens}
+	for _, via := range g.Via {
+		tokens = append(tokens, via.Tokens)
+	}
+	name := strings.Join(tokens, " * ")
+	if g.Network != "" {
+		name += " on " + g.Network
+	}
+	return name
+}
+
+// DivergenceGroup is a set of feeds that should track each other, possibly on
+// different networks. The group diverges once the highest and the lowest price
+// of its members are more than Threshold percent apart for longer than
+// Duration.
+type DivergenceGroup struct {
+	Name      string
+	Members   []GroupMember
+	Threshold float64
+	Duration  time.Duration
+}
+
+// networkPrice queries the latest price of feed on another network.
+func (m *Monitor) networkPrice(ctx context.Context, backend bind.ContractCaller, feed Feed) (decimal.Decimal, error) {
+	source, err := newFeedSource(feed, backend)
+	if err != nil {
+		return decimal.Decimal{}, err
+	}
+	opts, cancel := m.callOpts(ctx)
+	defer cancel()
+	round, err := queryRound(opts, source, feed, nil)
+	if err != nil {
+		return decimal.Decimal{}, err
+	}
+	return round.Price, nil
+}
+
+// memberPrice returns the price of member, or the feed that failed to be
+// queried.
+func (m *Monitor) memberPrice(ctx context.Context, member GroupMember) (decimal.Decimal, Feed, error) {
+	feedPrice := func(feed Feed) (decimal.Decimal, error) {
+		price, _, err := m.chainlinkPrice(ctx, feed)
+		return price, err
+	}
+	if member.Network != "" {
+		network, ok := m.config.Networks[member.Network]
+		if !ok {
+			return decimal.Decimal{}, member.Feed, fmt.Errorf("unknown network %q", member.Network)
+		}
+		backend := network.Backend
+		feedPrice = func(feed Feed) (decimal.Decimal, error) {
+			return m.networkPrice(ctx, backend, feed)
+		}
+	}
+
+	price, err := feedPrice(member.Feed)
+	if err != nil {
+		return decimal.Decimal{}, member.Feed, err
+	}
+	for _, via := range member.Via {
+		viaPrice, err := feedPrice(via)
+		if err != nil {
+			return decimal.Decimal{}, via, err
+		}
+		price = price.Mul(viaPrice, groupPriceScale)
+	}
+	return price, Feed{}, nil
+}
+
+// groupDivergence queries the prices of all members and returns how far the
+// highest one is from the lowest in percent.
+func (m *Monitor) groupDivergence(ctx context.Context, group DivergenceGroup) ([]MemberPrice, float64, Feed, error) {
+	prices := make([]MemberPrice, 0, len(group.Members))
+	for _, member := range group.Members {
+		price, failed, err := m.memberPrice(ctx, member)
+		if err != nil {
+			return nil, 0, failed, err
+		}
+		prices = append(prices, MemberPrice{Name: member.Name(), Price: price})
+	}
+	lowest, highest := prices[0].Price, prices[0].Price
+	for _, price := range prices[1:] {
+		if price.Price.Cmp(lowest) < 0 {
+			lowest = price.Price
+		}
+		if price.Price.Cmp(highest) > 0 {
+			highest = price.Price
+		}
+	}
+	if lowest.Sign() <= 0 {
+		return nil, 0, Feed{}, errZeroAnswer
+	}
+	divergence, err := highest.Sub(lowest).Quo(lowest, deviationScale)
+	if err != nil {
+		return nil, 0, Feed{}, err
+	}
+	return prices, divergence.Float64() * 100, Feed{}, nil
+}
+
+// groupUnreliable reports whether the sequencer of a network of the members
+// of group is down or in its grace period at now. A status that can't be
+// queried is reported and counts as unreliable.
+func (m *Monitor) groupUnreliable(ctx context.Context, group DivergenceGroup, now time.Time) bool {
+	checked := make(map[string]bool)
+	unreliable := false
+	for _, member := range group.Members {
+		if checked[member.Network] {
+			continue
+		}
+		checked[member.Network] = true
+		networkUnreliable, err := m.networkUnreliable(ctx, member.Network, now)
+		if err != nil {
+			feed := Feed{Tokens: sequencerFeedName, Address: m.config.Networks[member.Network].SequencerUptimeFeed}
+			m.feedError(ctx, feed, fmt.Sprintf("Failed acquiring sequencer status of %s", member.Network), err)
+		}
+		unreliable = unreliable || networkUnreliable
+	}
+	return unreliable
+}
+
+// watchDivergence compares the prices of the members of group every
+// PollInterval and emits a Divergence once they stayed apart for longer than
+// the group's duration and again once they converge.
+func (m *Monitor) watchDivergence(ctx context.Context, group DivergenceGroup, wg *sync.WaitGroup) {
+	defer wg.Done()
+
+	ticker := time.NewTicker(m.config.PollInterval)
+	defer ticker.Stop()
+
+	var since time.Time
+	diverged := false
+	for {
+		prices, divergence, failed, err := m.groupDivergence(ctx, group)
+		if err != nil {
+			m.feedError(ctx, failed, fmt.Sprintf("Failed comparing prices of group %s", group.Name), err)
+		} else if divergence > group.Threshold {
+			if since.IsZero() {
+				since = time.Now()
+			}
+			if !diverged && time.Since(since) >= group.Duration {
+				diverged = true
+				m.emit(ctx, &Divergence{
+					Group:             group.Name,
+					Diverged:          true,
+					Since:             since,
+					Prices:            prices,
+					DivergencePercent: divergence,
+					Unreliable:        m.groupUnreliable(ctx, group, time.Now()),
+				})
+			}
+		} else {
+			if diverged {
+				diverged = false
+				m.emit(ctx, &Divergence{
+					Group:             group.Name,
+					Diverged:          false,
+					Since:             since,
+					Prices:            prices,
+					DivergencePercent: divergence,
+					Unreliable:        m.groupUnreliable(ctx, group, time.Now()),
+				})
+			}
+			since = time.Time{}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
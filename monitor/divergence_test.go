@@ -0,0 +1,122 @@
+package monitor_test
+
+import (
+	"context"
+	"math/big"
+	"strings"
+	"testing"
+
+	"hw-3/monitor"
+)
+
+func TestDivergence(t *testing.T) {
+	chain := newChain(t)
+	ethUSD := deployFeed(t, chain, 2000_00000000)
+	ethBTC := deployFeed(t, chain, 5000000)
+	btcUSD := deployFeed(t, chain, 40000_00000000)
+	feeds := []monitor.Feed{
+		{Tokens: "ETH / USD", Address: ethUSD.Address},
+		{Tokens: "ETH / BTC", Address: ethBTC.Address},
+		{Tokens: "BTC / USD", Address: btcUSD.Address},
+	}
+	// The same pair on an L2
+	l2 := newChain(t)
+	l2ETHUSD := deployFeed(t, l2, 2000_00000000)
+	events := startMonitor(t, chain.Backend, monitor.Config{
+		Feeds:    feeds,
+		Networks: map[string]monitor.Network{"l2": {Backend: l2.Backend}},
+		DivergenceGroups: []monitor.DivergenceGroup{{
+			Name: "ETH / USD",
+			Members: []monitor.GroupMember{
+				{Feed: feeds[0]},
+				{Feed: monitor.Feed{Tokens: "ETH / USD", Address: l2ETHUSD.Address}, Network: "l2"},
+				{Feed: feeds[1], Via: []monitor.Feed{feeds[2]}},
+			},
+			Threshold: 1,
+			Duration:  2 * pollInterval,
+		}},
+	})
+
+	if err := l2.PushAnswer(l2ETHUSD.Aggregator, big.NewInt(2100_00000000)); err != nil {
+		t.Fatal(err)
+	}
+	diverged := nextEvent[*monitor.Divergence](t, events)
+	if !diverged.Diverged || diverged.DivergencePercent != 5 || diverged.Unreliable {
+		t.Errorf("expected reliable divergence of 5%%, got %f (diverged %t, unreliable %t)",
+			diverged.DivergencePercent, diverged.Diverged, diverged.Unreliable)
+	}
+	for i, expected := range []string{"ETH / USD", "ETH / USD on l2", "ETH / BTC * BTC / USD"} {
+		if diverged.Prices[i].Name != expected {
+			t.Errorf("expected member %s, got %s", expected, diverged.Prices[i].Name)
+		}
+	}
+	if got := diverged.Prices[1].Price.String(); got != "2100.00000000" {
+		t.Errorf("expected L2 price 2100.00000000, got %s", got)
+	}
+	if got := diverged.Prices[2].Price.String(); got != "2000.000000000000000000" {
+		t.Errorf("expected synthetic price 2000.000000000000000000, got %s", got)
+	}
+
+	if err := l2.PushAnswer(l2ETHUSD.Aggregator, big.NewInt(2010_00000000)); err != nil {
+		t.Fatal(err)
+	}
+	converged := nextEvent[*monitor.Divergence](t, events)
+	if converged.Diverged || !converged.Since.Equal(diverged.Since) {
+		t.Errorf("expected convergence of the divergence since %s, got diverged %t since %s", diverged.Since, converged.Diverged, converged.Since)
+	}
+}
+
+func TestDivergenceSequencerDown(t *testing.T) {
+	chain := newChain(t)
+	ethUSD := deployFeed(t, chain, 2000_00000000)
+	l2 := newChain(t)
+	l2ETHUSD := deployFeed(t, l2, 2000_00000000)
+	// The sequencer of the L2 is down, its feed is stale
+	uptime, err := l2.DeployFeed(0, big.NewInt(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	events := startMonitor(t, chain.Backend, monitor.Config{
+		Networks: map[string]monitor.Network{"l2": {Backend: l2.Backend, SequencerUptimeFeed: uptime.Address}},
+		DivergenceGroups: []monitor.DivergenceGroup{{
+			Name: "ETH / USD",
+			Members: []monitor.GroupMember{
+				{Feed: monitor.Feed{Tokens: "ETH / USD", Address: ethUSD.Address}},
+				{Feed: monitor.Feed{Tokens: "ETH / USD", Address: l2ETHUSD.Address}, Network: "l2"},
+			},
+			Threshold: 1,
+		}},
+	})
+
+	if err := chain.PushAnswer(ethUSD.Aggregator, big.NewInt(2100_00000000)); err != nil {
+		t.Fatal(err)
+	}
+	if diverged := nextEvent[*monitor.Divergence](t, events); !diverged.Diverged || !diverged.Unreliable {
+		t.Errorf("expected unreliable divergence, got diverged %t, unreliable %t", diverged.Diverged, diverged.Unreliable)
+	}
+}
+
+func TestDivergenceUnknownNetwork(t *testing.T) {
+	chain := newChain(t)
+	ethUSD := deployFeed(t, chain, 2000_00000000)
+	feed := monitor.Feed{Tokens: "ETH / USD", Address: ethUSD.Address}
+	mon := monitor.New(monitor.Config{
+		DivergenceGroups: []monitor.DivergenceGroup{{
+			Name:    "ETH / USD",
+			Members: []monitor.GroupMember{{Feed: feed}, {Feed: feed, Network: "l2"}},
+		}},
+		PollInterval: pollInterval,
+	}, chain.Backend)
+	ctx, cancel := context.WithCancel(context.Background())
+	go mon.Run(ctx)
+	t.Cleanup(func() {
+		cancel()
+		for range mon.Events() {
+		}
+	})
+
+	feedErr := skipTo[*monitor.FeedError](t, mon.Events())
+	if feedErr.Message != "Failed comparing prices of group ETH / USD" || !strings.Contains(feedErr.Error(), `unknown network "l2"`) {
+		t.Errorf("unexpected error %s", feedErr)
+	}
+}
@@ -0,0 +1,131 @@
+package monitor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"hw-3/ens"
+)
+
+var (
+	errNoResolver = errors.New("name has no resolver")
+	errNoAddress  = errors.New("name does not resolve to an address")
+)
+
+// resolvedNames keeps the proxy addresses the ENS names of feeds resolve to.
+type resolvedNames struct {
+	mu        sync.RWMutex
+	addresses map[string]common.Address
+}
+
+func newResolvedNames() *resolvedNames {
+	return &resolvedNames{addresses: make(map[string]common.Address)}
+}
+
+func (n *resolvedNames) set(name string, address common.Address) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.addresses[name] = address
+}
+
+// resolve fills in the address of feed if it has a resolved name.
+func (n *resolvedNames) resolve(feed Feed) Feed {
+	if feed.Name == "" {
+		return feed
+	}
+	n.mu.RLock()
+	defer n.mu.RUnlock()
+	feed.Address = n.addresses[feed.Name]
+	return feed
+}
+
+// ResolveName resolves an ENS name like eth-usd.data.eth to the address it
+// points to.
+func ResolveName(opts *bind.CallOpts, backend bind.ContractCaller, name string) (common.Address, error) {
+	registry, err := ens.NewENSCaller(ens.RegistryAddress, backend)
+	if err != nil {
+		return common.Address{}, fmt.Errorf("failed acquiring ENS registry instance: %w", err)
+	}
+	node := ens.NameHash(name)
+	resolverAddress, err := registry.Resolver(opts, node)
+	if err != nil {
+		return common.Address{}, fmt.Errorf("failed querying resolver: %w", err)
+	}
+	if resolverAddress == (common.Address{}) {
+		return common.Address{}, errNoResolver
+	}
+	resolver, err := ens.NewResolverCaller(resolverAddress, backend)
+	if err != nil {
+		return common.Address{}, fmt.Errorf("failed acquiring resolver instance: %w", err)
+	}
+	address, err := resolver.Addr(opts, node)
+	if err != nil {
+		return common.Address{}, fmt.Errorf("failed querying address: %w", err)
+	}
+	if address == (common.Address{}) {
+		return common.Address{}, errNoAddress
+	}
+	return address, nil
+}
+
+// followName monitors the feed whose proxy the ENS name of feed resolves to.
+// The name is resolved again every poll interval, when it points to another
+// proxy the old one is no longer monitored. Until the name is resolved for
+// the first time nothing is monitored.
+func (m *Monitor) followName(ctx context.Context, feed Feed, wg *sync.WaitGroup) {
+	defer wg.Done()
+
+	feedWg := sync.WaitGroup{}
+	stopFeed := func() {}
+	defer func() {
+		stopFeed()
+		feedWg.Wait()
+	}()
+
+	ticker := time.NewTicker(m.config.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		opts, cancel := m.callOpts(ctx)
+		address, err := ResolveName(opts, m.backend, feed.Name)
+		cancel()
+		switch {
+		case err != nil:
+			m.feedError(ctx, feed, "Failed resolving ENS name", err)
+		case address != feed.Address:
+			stopFeed()
+			feedWg.Wait()
+			if feed.Address != (common.Address{}) {
+				m.emit(ctx, &GovernanceAlert{
+					Feed: feed,
+					Kind: NameChanged,
+					From: feed.Address,
+					To:   address,
+				})
+			}
+			feed.Address = address
+			m.names.set(feed.Name, address)
+
+			stopFeed = m.startFeed(ctx, feed, &feedWg)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// startFeed monitors feed until the returned function is called.
+func (m *Monitor) startFeed(ctx context.Context, feed Feed, wg *sync.WaitGroup) context.CancelFunc {
+	feedCtx, cancel := context.WithCancel(ctx)
+	wg.Add(1)
+	go m.monitorFeed(feedCtx, feed, wg)
+	return cancel
+}
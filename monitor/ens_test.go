@@ -0,0 +1,102 @@
+package monitor_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"hw-3/ens"
+	"hw-3/fakenode"
+	"hw-3/monitor"
+	"hw-3/proxy"
+)
+
+var resolverAddress = common.HexToAddress("0x4976fb03C32e5B8cfe2b6cCB31c09Ba78EBaBa41")
+
+// setName makes every ENS name resolve to address on node.
+func setName(t *testing.T, node *fakenode.Node, address common.Address) {
+	t.Helper()
+	ensABI := parseABI(t, ens.ENSMetaData.ABI)
+	resolverABI := parseABI(t, ens.ResolverMetaData.ABI)
+	for _, err := range []error{
+		node.SetCallResult(ens.RegistryAddress, ensABI, "resolver", resolverAddress),
+		node.SetCallResult(resolverAddress, resolverABI, "addr", address),
+	} {
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestENSFeed(t *testing.T) {
+	node := feedNode(t)
+	setName(t, node, feedAddress)
+	events := startMonitor(t, dialNode(t, node), monitor.Config{
+		Feeds: []monitor.Feed{{Tokens: "ETH / USD", Name: "eth-usd.data.eth"}},
+	})
+
+	node.PushLogs(answerUpdated(t, node.Mine(), 1234_00000000, 1))
+	price := nextEvent[*monitor.PriceUpdate](t, events)
+	if price.Feed.Address != feedAddress || price.Feed.Name != "eth-usd.data.eth" {
+		t.Errorf("expected price update of %s at %s, got %s at %s", "eth-usd.data.eth", feedAddress, price.Feed.Name, price.Feed.Address)
+	}
+
+	// The name is pointed to another proxy using the same aggregator
+	next := common.HexToAddress("0xF9680D99D6C9589e2a93a78A04A279e509205945")
+	proxyABI := parseABI(t, proxy.ProxyMetaData.ABI)
+	for _, err := range []error{
+		node.SetCallResult(next, proxyABI, "aggregator", aggregatorAddress),
+		node.SetCallResult(next, proxyABI, "proposedAggregator", common.Address{}),
+		node.SetCallResult(next, proxyABI, "phaseId", uint16(1)),
+	} {
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	setName(t, node, next)
+
+	alert := nextEvent[*monitor.GovernanceAlert](t, events)
+	if alert.Kind != monitor.NameChanged || alert.From != feedAddress || alert.To != next {
+		t.Errorf("unexpected alert %s from %s to %s", alert.Kind, alert.From, alert.To)
+	}
+	for {
+		subscribed := nextEvent[*monitor.Subscribed](t, events)
+		if subscribed.Kind == monitor.SubscriptionPrice {
+			if subscribed.Address != next {
+				t.Errorf("expected price subscription of %s, got %s", next, subscribed.Address)
+			}
+			break
+		}
+	}
+}
+
+func TestUnresolvedName(t *testing.T) {
+	node := feedNode(t)
+	setName(t, node, common.Address{})
+	mon := monitor.New(monitor.Config{
+		Feeds: []monitor.Feed{
+			{Tokens: "BTC / USD", Name: "btc-usd.data.eth"},
+			{Tokens: "ETH / USD", Address: feedAddress},
+		},
+		PollInterval: pollInterval,
+	}, dialNode(t, node))
+	ctx, cancel := context.WithCancel(context.Background())
+	go mon.Run(ctx)
+	t.Cleanup(func() {
+		cancel()
+		for range mon.Events() {
+		}
+	})
+
+	feedErr := skipTo[*monitor.FeedError](t, mon.Events())
+	if feedErr.Message != "Failed resolving ENS name" || feedErr.Feed.Name != "btc-usd.data.eth" {
+		t.Errorf("unexpected error %s of %s", feedErr, feedErr.Feed.Tokens)
+	}
+
+	// Blocks, price updates and both ownership events of the other feed
+	waitSubscriptions(t, node, 4)
+	node.PushLogs(answerUpdated(t, node.Mine(), 1234_00000000, 1))
+	if price := skipTo[*monitor.PriceUpdate](t, mon.Events()); price.Feed.Tokens != "ETH / USD" {
+		t.Errorf("expected price update of ETH / USD, got %s", price.Feed.Tokens)
+	}
+}
@@ -0,0 +1,333 @@
+package monitor
+
+import (
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"hw-3/decimal"
+)
+
+// Event is implemented by everything the Monitor emits.
+type Event interface {
+	event()
+}
+
+// SubscriptionKind tells what a Subscribed event refers to.
+type SubscriptionKind string
+
+const (
+	SubscriptionBlocks     SubscriptionKind = "blocks"
+	SubscriptionPrice      SubscriptionKind = "price"
+	SubscriptionGovernance SubscriptionKind = "governance"
+	SubscriptionContract   SubscriptionKind = "contract"
+	SubscriptionDEX        SubscriptionKind = "dex"
+	SubscriptionPending    SubscriptionKind = "pending"
+	SubscriptionOwnership  SubscriptionKind = "ownership"
+)
+
+// Subscribed is emitted once a subscription is established. Name and Address
+// are the tokens and the proxy of a feed, the tokens of a feed and the
+// address of a DEX pool, the name and the address of a contract or of a Feed
+// Registry whose ownership is watched. Aggregator is only set for feeds and
+// Events only for contracts.
+type Subscribed struct {
+	Kind       SubscriptionKind
+	Name       string
+	Address    common.Address
+	Aggregator common.Address
+	Events     []string
+}
+
+// NewBlock is emitted for every new block.
+type NewBlock struct {
+	Number       uint64
+	Hash         common.Hash
+	Time         time.Time
+	BaseFee      *big.Int
+	Transactions int
+}
+
+// GasUpdate carries gas price suggestions recalculated after a new block.
+type GasUpdate struct {
+	Estimate *GasEstimate
+}
+
+// PriceUpdate is emitted for every AnswerUpdated event of a feed. RoundID is
+// the round id of the aggregator, which is the PhaseID-th aggregator of the
+// proxy or registry entry, see ProxyRoundID. UpdatedAt is the updatedAt of
+// the round, BlockTime the timestamp of the block including the event and
+// ReceivedAt when the monitor received it. On L2 networks with a sequencer
+// uptime feed, Unreliable is set if the price was updated while the sequencer
+// was down or shortly after it came back up.
+type PriceUpdate struct {
+	Feed       Feed
+	Aggregator common.Address
+	PhaseID    uint16
+	RoundID    *big.Int
+	Answer     *big.Int
+	Decimals   uint8
+	Price      decimal.Decimal
+	UpdatedAt  time.Time
+	BlockTime  time.Time
+	ReceivedAt time.Time
+	Unreliable bool
+	Log        types.Log
+}
+
+// UpdateCost is emitted after a PriceUpdate when update costs are enabled.
+// Transmitter sent the transaction delivering the update, Cost is what it
+// paid in ETH.
+type UpdateCost struct {
+	Feed              Feed
+	RoundID           *big.Int
+	TxHash            common.Hash
+	Transmitter       common.Address
+	GasUsed           uint64
+	EffectiveGasPrice *big.Int
+	Cost              decimal.Decimal
+}
+
+// PriceReverted is emitted when the block containing a price update is
+// removed from the chain by a reorg. Unreliable is set like for PriceUpdate,
+// based on the updatedAt of the reverted round.
+type PriceReverted struct {
+	Feed       Feed
+	Aggregator common.Address
+	RoundID    *big.Int
+	Answer     *big.Int
+	Decimals   uint8
+	Price      decimal.Decimal
+	Unreliable bool
+	Log        types.Log
+}
+
+// Transmission is emitted for every NewTransmission event of an OCR
+// aggregator, once per round before its PriceUpdate. Observers are the indices
+// of the oracles whose Observations made it into the report, in the same
+// order. Spread is the difference between the highest and the lowest
+// observation, SpreadPercent relates it to the Median. Unreliable is set like
+// for PriceUpdate, based on the timestamp of the block including the event.
+type Transmission struct {
+	Feed          Feed
+	Aggregator    common.Address
+	RoundID       uint32
+	Answer        *big.Int
+	Decimals      uint8
+	Price         decimal.Decimal
+	Transmitter   common.Address
+	Observers     []int
+	Observations  []decimal.Decimal
+	Median        decimal.Decimal
+	Spread        decimal.Decimal
+	SpreadPercent float64
+	Unreliable    bool
+	Log           types.Log
+}
+
+// PendingPrice is emitted for every pending OCR transmission to the
+// aggregator of a feed before it is mined. Answer is the median of the
+// observations of the Observers, SeenAt is when the transaction was first
+// seen in the mempool. Unreliable is set like for PriceUpdate, based on
+// SeenAt.
+type PendingPrice struct {
+	Feed        Feed
+	Aggregator  common.Address
+	TxHash      common.Hash
+	Transmitter common.Address
+	Answer      *big.Int
+	Decimals    uint8
+	Price       decimal.Decimal
+	Observers   []int
+	SeenAt      time.Time
+	Unreliable  bool
+}
+
+// SequencerStatus is emitted when the sequencer uptime feed of an L2 network
+// is first queried and whenever the status it reports changes. Since is when
+// the sequencer went up or down, prices updated before GracePeriodEnd are
+// unreliable even if it is up.
+type SequencerStatus struct {
+	Feed           common.Address
+	Up             bool
+	Since          time.Time
+	GracePeriodEnd time.Time
+}
+
+// RoundStarted is emitted for every NewRound event of a feed.
+type RoundStarted struct {
+	Feed      Feed
+	RoundID   uint64
+	StartedBy common.Address
+	StartedAt time.Time
+}
+
+// RoundAnswered is emitted when a started round receives its answer.
+type RoundAnswered struct {
+	Feed      Feed
+	RoundID   uint64
+	StartedBy common.Address
+	Duration  time.Duration
+}
+
+// RoundUnanswered is emitted for rounds that were started but never answered,
+// either because a later round was answered first or because of a timeout.
+type RoundUnanswered struct {
+	Feed      Feed
+	RoundID   uint64
+	StartedBy common.Address
+	StartedAt time.Time
+	Reason    string
+}
+
+// GovernanceKind tells what kind of governance change happened.
+type GovernanceKind string
+
+const (
+	OwnershipTransferRequested GovernanceKind = "ownershipTransferRequested"
+	OwnershipTransferred       GovernanceKind = "ownershipTransferred"
+	AggregatorProposed         GovernanceKind = "aggregatorProposed"
+	AggregatorConfirmed        GovernanceKind = "aggregatorConfirmed"
+	ProposalWithdrawn          GovernanceKind = "proposalWithdrawn"
+	NameChanged                GovernanceKind = "nameChanged"
+)
+
+// GovernanceAlert is emitted when ownership of a proxy moves or its aggregator
+// is proposed, confirmed or withdrawn. For ownership changes From and To are
+// owners, for aggregator changes they are aggregators: the current and the
+// proposed one for proposals, the old and the new one for confirmations.
+// When the ENS name of a feed is changed From and To are the old and the new
+// proxy. TxHash is only known for ownership changes.
+type GovernanceAlert struct {
+	Feed   Feed
+	Kind   GovernanceKind
+	From   common.Address
+	To     common.Address
+	TxHash common.Hash
+}
+
+// ProposedDeviation compares the latest answers of the current and the
+// proposed aggregators of a feed.
+type ProposedDeviation struct {
+	Feed              Feed
+	Proposed          common.Address
+	CurrentRoundID    *big.Int
+	CurrentDecimals   uint8
+	CurrentPrice      decimal.Decimal
+	ProposedRoundID   *big.Int
+	ProposedDecimals  uint8
+	ProposedPrice     decimal.Decimal
+	ProposedUpdatedAt time.Time
+	DeviationPercent  float64
+}
+
+// DEXDeviation compares the spot price of a DEX pool at Block with the
+// latest answer of its feed. Unreliable is set like for PriceUpdate, based on
+// the timestamp of Block.
+type DEXDeviation struct {
+	Feed             Feed
+	Pool             common.Address
+	Kind             DEXKind
+	Block            uint64
+	DEXPrice         decimal.Decimal
+	ChainlinkPrice   decimal.Decimal
+	ChainlinkRoundID *big.Int
+	DeviationPercent float64
+	Unreliable       bool
+}
+
+// MemberPrice is the price of a divergence group member.
+type MemberPrice struct {
+	Name  string
+	Price decimal.Decimal
+}
+
+// Divergence is emitted when the prices of a divergence group have been
+// further apart than its threshold since Since for longer than its duration,
+// and again with Diverged false once they are back within the threshold.
+// Unreliable is set if the sequencer of the network of a member was down or
+// in its grace period when the event was emitted.
+type Divergence struct {
+	Group             string
+	Diverged          bool
+	Since             time.Time
+	Prices            []MemberPrice
+	DivergencePercent float64
+	Unreliable        bool
+}
+
+// ContractEvent is a decoded event of a configured contract.
+type ContractEvent struct {
+	Contract string
+	Event    string
+	Args     map[string]string
+	Log      types.Log
+}
+
+// BlockError is emitted when monitoring blocks fails.
+type BlockError struct {
+	Message string
+	Err     error
+}
+
+func (e *BlockError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Message, e.Err)
+}
+
+func (e *BlockError) Unwrap() error {
+	return e.Err
+}
+
+// FeedError is emitted when monitoring a feed fails.
+type FeedError struct {
+	Feed    Feed
+	Message string
+	Err     error
+}
+
+func (e *FeedError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Message, e.Err)
+}
+
+func (e *FeedError) Unwrap() error {
+	return e.Err
+}
+
+// ContractError is emitted when monitoring a contract fails.
+type ContractError struct {
+	Contract string
+	Address  common.Address
+	Message  string
+	Err      error
+}
+
+func (e *ContractError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Message, e.Err)
+}
+
+func (e *ContractError) Unwrap() error {
+	return e.Err
+}
+
+func (*Subscribed) event()        {}
+func (*NewBlock) event()          {}
+func (*GasUpdate) event()         {}
+func (*PriceUpdate) event()       {}
+func (*UpdateCost) event()        {}
+func (*PriceReverted) event()     {}
+func (*RoundStarted) event()      {}
+func (*RoundAnswered) event()     {}
+func (*RoundUnanswered) event()   {}
+func (*GovernanceAlert) event()   {}
+func (*ProposedDeviation) event() {}
+func (*DEXDeviation) event()      {}
+func (*Divergence) event()        {}
+func (*Transmission) event()      {}
+func (*PendingPrice) event()      {}
+func (*SequencerStatus) event()   {}
+func (*ContractEvent) event()     {}
+func (*BlockError) event()        {}
+func (*FeedError) event()         {}
+func (*ContractError) event()     {}
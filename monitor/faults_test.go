@@ -0,0 +1,254 @@
+package monitor_test
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
+	"hw-3/aggregator"
+	"hw-3/fakenode"
+	"hw-3/monitor"
+	"hw-3/proxy"
+)
+
+var (
+	feedAddress       = common.HexToAddress("0x5f4eC3Df9cbd43714FE2740f5E3616155c5b8419")
+	aggregatorAddress = common.HexToAddress("0x37bC7498f4FF12C19678ee8fE19d713b87F6a9e6")
+)
+
+// scriptedNode starts a fake node serving a feed with 8 decimals at
+// feedAddress and a monitor connected to it over websocket.
+func scriptedNode(t *testing.T, config monitor.Config) (*fakenode.Node, <-chan monitor.Event) {
+	t.Helper()
+	node := feedNode(t)
+	return node, startMonitor(t, dialNode(t, node), config)
+}
+
+// feedNode starts a fake node serving a feed with 8 decimals at feedAddress.
+func feedNode(t *testing.T) *fakenode.Node {
+	t.Helper()
+	node, err := fakenode.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(node.Close)
+
+	proxyABI := parseABI(t, proxy.ProxyMetaData.ABI)
+	aggABI := parseABI(t, aggregator.AggregatorMetaData.ABI)
+	for _, err := range []error{
+		node.SetCallResult(feedAddress, proxyABI, "aggregator", aggregatorAddress),
+		node.SetCallResult(feedAddress, proxyABI, "proposedAggregator", common.Address{}),
+		node.SetCallResult(feedAddress, proxyABI, "phaseId", uint16(1)),
+		node.SetCallResult(aggregatorAddress, aggABI, "decimals", uint8(8)),
+	} {
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	return node
+}
+
+func dialNode(t *testing.T, node *fakenode.Node) *ethclient.Client {
+	t.Helper()
+	client, err := ethclient.DialContext(context.Background(), node.WebsocketURL())
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(client.Close)
+	return client
+}
+
+func parseABI(t *testing.T, abiJSON string) abi.ABI {
+	t.Helper()
+	parsed, err := abi.JSON(strings.NewReader(abiJSON))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return parsed
+}
+
+func scriptedFeed() monitor.Config {
+	return monitor.Config{Feeds: []monitor.Feed{{Tokens: "ETH / USD", Address: feedAddress}}}
+}
+
+// answerUpdated builds the log of an AnswerUpdated event included in block.
+func answerUpdated(t *testing.T, block *types.Header, answer, roundID int64) types.Log {
+	t.Helper()
+	aggABI := parseABI(t, aggregator.AggregatorMetaData.ABI)
+	return types.Log{
+		Address: aggregatorAddress,
+		Topics: []common.Hash{
+			aggABI.Events["AnswerUpdated"].ID,
+			common.BigToHash(big.NewInt(answer)),
+			common.BigToHash(big.NewInt(roundID)),
+		},
+		Data:        common.BigToHash(new(big.Int).SetUint64(block.Time)).Bytes(),
+		BlockNumber: block.Number.Uint64(),
+		BlockHash:   block.Hash(),
+		TxHash:      common.BigToHash(big.NewInt(roundID)),
+	}
+}
+
+// skipTo skips events, errors included, until one of type T arrives.
+func skipTo[T monitor.Event](t *testing.T, events <-chan monitor.Event) T {
+	t.Helper()
+	timeout := time.After(eventTimeout)
+	for {
+		select {
+		case ev, ok := <-events:
+			if !ok {
+				t.Fatal("events channel closed")
+			}
+			if typed, ok := ev.(T); ok {
+				return typed
+			}
+		case <-timeout:
+			var zero T
+			t.Fatalf("timed out waiting for %T", zero)
+		}
+	}
+}
+
+func waitSubscriptions(t *testing.T, node *fakenode.Node, count int) {
+	t.Helper()
+	deadline := time.Now().Add(eventTimeout)
+	for node.Subscriptions() != count {
+		if time.Now().After(deadline) {
+			t.Fatalf("expected %d subscriptions, got %d", count, node.Subscriptions())
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestDroppedSubscriptions(t *testing.T) {
+	node, events := scriptedNode(t, scriptedFeed())
+	// Blocks, price updates and both ownership events
+	const subscriptions = 4
+	waitSubscriptions(t, node, subscriptions)
+
+	if err := node.DropSubscriptions(); err != nil {
+		t.Fatal(err)
+	}
+	blockErr := skipTo[*monitor.BlockError](t, events)
+	if blockErr.Message != "Failed while listening for new blocks" {
+		t.Errorf("unexpected error %s", blockErr)
+	}
+	waitSubscriptions(t, node, subscriptions)
+
+	block := node.Mine()
+	if got := skipTo[*monitor.NewBlock](t, events); got.Hash != block.Hash() {
+		t.Errorf("expected block %s after resubscribing, got %s", block.Hash(), got.Hash)
+	}
+	node.PushLogs(answerUpdated(t, block, 1234_00000000, 1))
+	if got := skipTo[*monitor.PriceUpdate](t, events); got.RoundID.Int64() != 1 {
+		t.Errorf("expected round 1 after resubscribing, got %s", got.RoundID)
+	}
+}
+
+func TestRateLimitedResubscription(t *testing.T) {
+	node, events := scriptedNode(t, monitor.Config{})
+	waitSubscriptions(t, node, 1)
+
+	node.RateLimit(1)
+	if err := node.DropSubscriptions(); err != nil {
+		t.Fatal(err)
+	}
+	skipTo[*monitor.BlockError](t, events)
+	resubErr := nextEvent[*monitor.BlockError](t, events)
+	var rpcErr rpc.Error
+	if !errors.As(resubErr, &rpcErr) || rpcErr.ErrorCode() != fakenode.RateLimitCode {
+		t.Errorf("expected rate limit error, got %s", resubErr)
+	}
+	waitSubscriptions(t, node, 1)
+
+	block := node.Mine()
+	if got := nextEvent[*monitor.NewBlock](t, events); got.Hash != block.Hash() {
+		t.Errorf("expected block %s, got %s", block.Hash(), got.Hash)
+	}
+}
+
+func TestRateLimitedBlock(t *testing.T) {
+	node, events := scriptedNode(t, monitor.Config{})
+
+	node.RateLimit(1)
+	node.Mine()
+	blockErr := nextEvent[*monitor.BlockError](t, events)
+	var rpcErr rpc.Error
+	if !errors.As(blockErr, &rpcErr) || rpcErr.ErrorCode() != fakenode.RateLimitCode {
+		t.Errorf("expected rate limit error, got %s", blockErr)
+	}
+
+	block := node.Mine()
+	if got := nextEvent[*monitor.NewBlock](t, events); got.Hash != block.Hash() {
+		t.Errorf("expected block %s, got %s", block.Hash(), got.Hash)
+	}
+}
+
+func TestSlowNode(t *testing.T) {
+	node, events := scriptedNode(t, monitor.Config{QueryTimeout: 100 * time.Millisecond})
+
+	node.SetDelay(time.Second)
+	node.Mine()
+	blockErr := nextEvent[*monitor.BlockError](t, events)
+	if !errors.Is(blockErr, context.DeadlineExceeded) {
+		t.Errorf("expected deadline exceeded, got %s", blockErr)
+	}
+
+	node.SetDelay(0)
+	block := node.Mine()
+	if got := nextEvent[*monitor.NewBlock](t, events); got.Hash != block.Hash() {
+		t.Errorf("expected block %s, got %s", block.Hash(), got.Hash)
+	}
+}
+
+func TestMalformedLogs(t *testing.T) {
+	node, events := scriptedNode(t, scriptedFeed())
+	block := node.Mine()
+
+	truncated := answerUpdated(t, block, 1234_00000000, 1)
+	truncated.Data = truncated.Data[:16]
+	empty := answerUpdated(t, block, 1234_00000000, 2)
+	empty.Data = nil
+	node.PushLogs(truncated, empty, answerUpdated(t, block, 1234_00000000, 3))
+
+	for i := 0; i < 2; i++ {
+		feedErr := nextEvent[*monitor.FeedError](t, events)
+		if feedErr.Message != "Failed decoding price update" {
+			t.Errorf("expected decoding error, got %s", feedErr)
+		}
+	}
+	if got := nextEvent[*monitor.PriceUpdate](t, events); got.RoundID.Int64() != 3 {
+		t.Errorf("expected round 3 to be decoded, got %s", got.RoundID)
+	}
+}
+
+func TestReorgedPrice(t *testing.T) {
+	node, events := scriptedNode(t, scriptedFeed())
+
+	block := node.Mine()
+	reverted := answerUpdated(t, block, 1234_00000000, 1)
+	node.PushLogs(reverted)
+	nextEvent[*monitor.PriceUpdate](t, events)
+
+	sibling := node.Reorg(reverted)
+	got := nextEvent[*monitor.PriceReverted](t, events)
+	if got.RoundID.Int64() != 1 || got.Log.BlockHash != block.Hash() {
+		t.Errorf("expected round 1 of block %s to be reverted, got round %s of %s", block.Hash(), got.RoundID, got.Log.BlockHash)
+	}
+	if got := got.Price.String(); got != "1234.00000000" {
+		t.Errorf("expected reverted price 1234.00000000, got %s", got)
+	}
+
+	node.PushLogs(answerUpdated(t, sibling, 1235_00000000, 1))
+	if got := nextEvent[*monitor.PriceUpdate](t, events); got.Log.BlockHash != sibling.Hash() {
+		t.Errorf("expected price update from block %s, got %s", sibling.Hash(), got.Log.BlockHash)
+	}
+}
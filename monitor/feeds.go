@@ -0,0 +1,241 @@
+package monitor
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"hw-3/aggregator"
+	"hw-3/decimal"
+	"hw-3/ocr"
+)
+
+var (
+	errNoTopics = errors.New("log has no topics")
+	errNoData   = errors.New("log has no data")
+
+	aggregatorABI   = mustParseABI(aggregator.AggregatorMetaData.ABI)
+	answerUpdatedID = aggregatorABI.Events["AnswerUpdated"].ID
+	newRoundID      = aggregatorABI.Events["NewRound"].ID
+)
+
+func mustParseABI(abiJSON string) abi.ABI {
+	parsed, err := abi.JSON(strings.NewReader(abiJSON))
+	if err != nil {
+		panic(err)
+	}
+	return parsed
+}
+
+func (m *Monitor) subscribeEvents(ctx context.Context, feed Feed, wg *sync.WaitGroup) {
+	defer wg.Done()
+
+	source, err := newFeedSource(feed, m.backend)
+	if err != nil {
+		m.feedError(ctx, feed, "Failed acquiring feed source", err)
+		return
+	}
+
+	opts, cancel := m.callOpts(ctx)
+	aggregatorAddress, err := source.aggregator(opts)
+	cancel()
+	if err != nil {
+		m.feedError(ctx, feed, "Failed acquiring aggregator address", err)
+		return
+	}
+
+	// Once the feed is switched to another aggregator its events are followed instead
+	for aggregatorAddress != (common.Address{}) {
+		aggregatorAddress = m.watchAggregator(ctx, feed, source, aggregatorAddress)
+	}
+}
+
+// watchAggregator emits the price updates and rounds of the aggregator at
+// aggregatorAddress until ctx is cancelled or the feed is switched to
+// another aggregator, whose address is returned then. The zero address is
+// returned if watching fails or ctx is cancelled.
+func (m *Monitor) watchAggregator(ctx context.Context, feed Feed, source feedSource, aggregatorAddress common.Address) common.Address {
+	aggregatorInstance, err := aggregator.NewAggregator(aggregatorAddress, m.backend)
+	if err != nil {
+		m.feedError(ctx, feed, "Failed acquiring aggregator instance", err)
+		return common.Address{}
+	}
+
+	opts, cancel := m.callOpts(ctx)
+	aggregatorDecimals, err := aggregatorInstance.Decimals(opts)
+	if err != nil {
+		cancel()
+		m.feedError(ctx, feed, "Failed acquiring decimals", err)
+		return common.Address{}
+	}
+	phaseID, err := source.phaseID(opts)
+	cancel()
+	if err != nil {
+		m.feedError(ctx, feed, "Failed acquiring phase id", err)
+		return common.Address{}
+	}
+
+	transmissions, err := ocr.NewOffchainAggregatorFilterer(aggregatorAddress, m.backend)
+	if err != nil {
+		m.feedError(ctx, feed, "Failed acquiring OCR aggregator instance", err)
+		return common.Address{}
+	}
+	removeTarget := m.aggregators.add(aggregatorAddress, pendingTarget{feed: feed, decimals: aggregatorDecimals})
+	defer removeTarget()
+
+	// All events come through a single subscription, so a round is always seen starting before it is answered.
+	// Only OCR aggregators emit NewTransmission.
+	logs := make(chan types.Log)
+	query := ethereum.FilterQuery{
+		Addresses: []common.Address{aggregatorAddress},
+		Topics:    [][]common.Hash{{answerUpdatedID, newRoundID, newTransmissionID}},
+	}
+	sub, err := m.backend.SubscribeFilterLogs(ctx, query, logs)
+	if err != nil {
+		m.feedError(ctx, feed, "Failed subscribing to price updates", err)
+		return common.Address{}
+	}
+	resub := resubscribe(sub,
+		func(subCtx context.Context) (ethereum.Subscription, error) {
+			return m.backend.SubscribeFilterLogs(subCtx, query, logs)
+		},
+		func(err error) { m.feedError(ctx, feed, "Failed while listening for events", err) },
+		func(err error) { m.feedError(ctx, feed, "Failed resubscribing to price updates", err) })
+	defer resub.Unsubscribe()
+
+	pollTicker := time.NewTicker(m.config.PollInterval)
+	defer pollTicker.Stop()
+	tracker := newRoundTracker(feed)
+	expireTicker := time.NewTicker(roundAnswerTimeout / 4)
+	defer expireTicker.Stop()
+
+	m.emit(ctx, &Subscribed{
+		Kind:       SubscriptionPrice,
+		Name:       feed.Tokens,
+		Address:    feed.Address,
+		Aggregator: aggregatorAddress,
+	})
+	for {
+		select {
+		case <-ctx.Done():
+			return common.Address{}
+		case <-pollTicker.C:
+			opts, cancel := m.callOpts(ctx)
+			current, err := source.aggregator(opts)
+			cancel()
+			if err != nil {
+				m.feedError(ctx, feed, "Failed acquiring aggregator address", err)
+				continue
+			}
+			if current != aggregatorAddress && current != (common.Address{}) {
+				return current
+			}
+		case <-expireTicker.C:
+			for _, ev := range tracker.expire(m.blocks.latestTime()) {
+				m.emit(ctx, ev)
+			}
+		case vLog := <-logs:
+			receivedAt := time.Now()
+			if len(vLog.Topics) == 0 {
+				m.feedError(ctx, feed, "Failed decoding event", errNoTopics)
+				continue
+			}
+			if vLog.Removed {
+				// Rounds are not rolled back, the chain replacing the block repeats their events
+				if vLog.Topics[0] != answerUpdatedID {
+					continue
+				}
+				ans, err := aggregatorInstance.ParseAnswerUpdated(vLog)
+				if err == nil && ans.UpdatedAt == nil {
+					err = errNoData
+				}
+				if err != nil {
+					m.feedError(ctx, feed, "Failed decoding reverted price update", err)
+					continue
+				}
+				m.emit(ctx, &PriceReverted{
+					Feed:       feed,
+					Aggregator: aggregatorAddress,
+					RoundID:    ans.RoundId,
+					Answer:     ans.Current,
+					Decimals:   aggregatorDecimals,
+					Price:      decimal.New(ans.Current, aggregatorDecimals),
+					Unreliable: m.sequencer.unreliable(time.Unix(ans.UpdatedAt.Int64(), 0)),
+					Log:        vLog,
+				})
+				continue
+			}
+			if vLog.Topics[0] == newRoundID {
+				round, err := aggregatorInstance.ParseNewRound(vLog)
+				if err != nil {
+					m.feedError(ctx, feed, "Failed decoding new round", err)
+					continue
+				}
+				m.emit(ctx, tracker.started(round))
+				continue
+			}
+			if vLog.Topics[0] == newTransmissionID {
+				ev, err := transmissions.ParseNewTransmission(vLog)
+				if err != nil {
+					m.feedError(ctx, feed, "Failed decoding transmission", err)
+					continue
+				}
+				transmission, err := newTransmission(feed, aggregatorDecimals, ev)
+				if err != nil {
+					m.feedError(ctx, feed, "Failed analyzing transmission", err)
+					continue
+				}
+				blockTime, err := m.blockTime(ctx, vLog)
+				if err != nil {
+					m.feedError(ctx, feed, "Failed querying block time", err)
+				}
+				transmission.Unreliable = m.sequencer.unreliable(blockTime)
+				m.emit(ctx, transmission)
+				continue
+			}
+
+			ans, err := aggregatorInstance.ParseAnswerUpdated(vLog)
+			if err == nil && ans.UpdatedAt == nil {
+				err = errNoData
+			}
+			if err != nil {
+				m.feedError(ctx, feed, "Failed decoding price update", err)
+				continue
+			}
+			for _, ev := range tracker.answered(ans) {
+				m.emit(ctx, ev)
+			}
+			updatedAt := time.Unix(ans.UpdatedAt.Int64(), 0)
+			blockTime, err := m.blockTime(ctx, vLog)
+			if err != nil {
+				m.feedError(ctx, feed, "Failed querying block time", err)
+			}
+			update := &PriceUpdate{
+				Feed:       feed,
+				Aggregator: aggregatorAddress,
+				PhaseID:    phaseID,
+				RoundID:    ans.RoundId,
+				Answer:     ans.Current,
+				Decimals:   aggregatorDecimals,
+				Price:      decimal.New(ans.Current, aggregatorDecimals),
+				UpdatedAt:  updatedAt,
+				BlockTime:  blockTime,
+				ReceivedAt: receivedAt,
+				Unreliable: m.sequencer.unreliable(updatedAt),
+				Log:        ans.Raw,
+			}
+			m.latest.set(update)
+			m.emit(ctx, update)
+			if m.costs != nil {
+				m.queueUpdateCost(ctx, update)
+			}
+		}
+	}
+}
@@ -0,0 +1,136 @@
+package monitor
+
+import (
+	"math/big"
+	"sort"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/consensus/misc"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+const (
+	gasOracleBlocks = 20
+)
+
+type gasSpeed struct {
+	name       string
+	percentile int
+}
+
+var gasSpeeds = []gasSpeed{
+	{name: "slow", percentile: 10},
+	{name: "standard", percentile: 50},
+	{name: "fast", percentile: 90},
+}
+
+// GasSuggestion is a suggested fee, all values are in wei.
+type GasSuggestion struct {
+	MaxPriorityFee *big.Int `json:"maxPriorityFeePerGas"`
+	MaxFee         *big.Int `json:"maxFeePerGas"`
+}
+
+// GasEstimate holds gas price suggestions for the block after Block,
+// keyed by speed: "slow", "standard" or "fast".
+type GasEstimate struct {
+	Block       uint64                   `json:"block"`
+	BaseFee     *big.Int                 `json:"baseFee"`
+	NextBaseFee *big.Int                 `json:"nextBaseFee"`
+	Suggestions map[string]GasSuggestion `json:"suggestions"`
+}
+
+// londonChainConfig treats every block with a base fee as following London,
+// for networks without a known chain config.
+var londonChainConfig = &params.ChainConfig{LondonBlock: big.NewInt(0)}
+
+// GasOracle keeps priority fee percentiles of the last gasOracleBlocks blocks
+// and suggests fees for the next one. It is safe for concurrent use.
+type GasOracle struct {
+	chainConfig *params.ChainConfig
+
+	mu      sync.RWMutex
+	samples [][]*big.Int
+	latest  *GasEstimate
+}
+
+// NewGasOracle creates an oracle with no blocks observed. The next base fee
+// is calculated with the fork rules of chainConfig, nil assumes London is
+// active on every block with a base fee.
+func NewGasOracle(chainConfig *params.ChainConfig) *GasOracle {
+	if chainConfig == nil {
+		chainConfig = londonChainConfig
+	}
+	return &GasOracle{chainConfig: chainConfig}
+}
+
+// AddBlock records priority fees of block and recalculates suggestions.
+func (o *GasOracle) AddBlock(block *types.Block) {
+	header := block.Header()
+	if header.BaseFee == nil {
+		return
+	}
+
+	tips := make([]*big.Int, 0, len(block.Transactions()))
+	for _, tx := range block.Transactions() {
+		tips = append(tips, tx.EffectiveGasTipValue(header.BaseFee))
+	}
+
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	if len(tips) > 0 {
+		sort.Slice(tips, func(i, j int) bool { return tips[i].Cmp(tips[j]) < 0 })
+		sample := make([]*big.Int, len(gasSpeeds))
+		for i, speed := range gasSpeeds {
+			sample[i] = percentile(tips, speed.percentile)
+		}
+		o.samples = append(o.samples, sample)
+		if len(o.samples) > gasOracleBlocks {
+			o.samples = o.samples[1:]
+		}
+	}
+
+	nextBaseFee := misc.CalcBaseFee(o.chainConfig, header)
+	estimate := &GasEstimate{
+		Block:       header.Number.Uint64(),
+		BaseFee:     new(big.Int).Set(header.BaseFee),
+		NextBaseFee: nextBaseFee,
+		Suggestions: make(map[string]GasSuggestion, len(gasSpeeds)),
+	}
+	for i, speed := range gasSpeeds {
+		tip := o.medianSample(i)
+		// Leave room for the base fee to double before the transaction is included
+		maxFee := new(big.Int).Mul(nextBaseFee, big.NewInt(2))
+		estimate.Suggestions[speed.name] = GasSuggestion{
+			MaxPriorityFee: tip,
+			MaxFee:         maxFee.Add(maxFee, tip),
+		}
+	}
+	o.latest = estimate
+}
+
+// percentile returns the value of sorted that percent of the values are
+// lower than or equal to.
+func percentile(sorted []*big.Int, percent int) *big.Int {
+	return sorted[(len(sorted)-1)*percent/100]
+}
+
+func (o *GasOracle) medianSample(speedIdx int) *big.Int {
+	if len(o.samples) == 0 {
+		return new(big.Int)
+	}
+	values := make([]*big.Int, len(o.samples))
+	for i, sample := range o.samples {
+		values[i] = sample[speedIdx]
+	}
+	sort.Slice(values, func(i, j int) bool { return values[i].Cmp(values[j]) < 0 })
+	return new(big.Int).Set(values[len(values)/2])
+}
+
+// Estimate returns the latest suggestions or nil if no block was added.
+func (o *GasOracle) Estimate() *GasEstimate {
+	o.mu.RLock()
+	defer o.mu.RUnlock()
+	return o.latest
+}
@@ -0,0 +1,170 @@
+package monitor
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/trie"
+)
+
+func gwei(value int64) *big.Int {
+	return new(big.Int).Mul(big.NewInt(value), big.NewInt(params.GWei))
+}
+
+// gasBlock builds a block with the given base fee and one transaction per
+// priority fee, all in gwei. The block uses half of its gas target.
+func gasBlock(number, baseFee int64, tips ...int64) *types.Block {
+	txs := make([]*types.Transaction, 0, len(tips))
+	for i, tip := range tips {
+		txs = append(txs, types.NewTx(&types.DynamicFeeTx{
+			Nonce:     uint64(i),
+			GasTipCap: gwei(tip),
+			GasFeeCap: new(big.Int).Add(gwei(baseFee), gwei(tip)),
+		}))
+	}
+	header := &types.Header{
+		Number:   big.NewInt(number),
+		GasLimit: 30_000_000,
+		GasUsed:  7_500_000,
+		BaseFee:  gwei(baseFee),
+	}
+	return types.NewBlock(header, txs, nil, nil, trie.NewStackTrie(nil))
+}
+
+func TestPercentile(t *testing.T) {
+	sorted := []*big.Int{big.NewInt(1), big.NewInt(2), big.NewInt(3), big.NewInt(4), big.NewInt(5),
+		big.NewInt(6), big.NewInt(7), big.NewInt(8), big.NewInt(9), big.NewInt(10)}
+	tests := []struct {
+		values   []*big.Int
+		percent  int
+		expected int64
+	}{
+		{sorted, 0, 1},
+		{sorted, 10, 1},
+		{sorted, 50, 5},
+		{sorted, 90, 9},
+		{sorted, 100, 10},
+		{sorted[:1], 90, 1},
+		{sorted[:2], 50, 1},
+	}
+	for _, test := range tests {
+		if got := percentile(test.values, test.percent); got.Int64() != test.expected {
+			t.Errorf("expected percentile %d of %d values to be %d, got %s", test.percent, len(test.values), test.expected, got)
+		}
+	}
+}
+
+func TestMedianSample(t *testing.T) {
+	tests := []struct {
+		name     string
+		samples  []int64
+		expected int64
+	}{
+		{"no samples", nil, 0},
+		{"one sample", []int64{7}, 7},
+		{"odd samples", []int64{5, 1, 3}, 3},
+		{"even samples", []int64{4, 1, 3, 2}, 3},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			oracle := NewGasOracle(nil)
+			for _, value := range test.samples {
+				oracle.samples = append(oracle.samples, []*big.Int{big.NewInt(value)})
+			}
+			if got := oracle.medianSample(0); got.Int64() != test.expected {
+				t.Errorf("expected median %d, got %s", test.expected, got)
+			}
+		})
+	}
+}
+
+func TestAddBlock(t *testing.T) {
+	tests := []struct {
+		name        string
+		chainConfig *params.ChainConfig
+		blocks      [][]int64
+		// expected tips of slow, standard and fast in gwei
+		expected    [3]int64
+		nextBaseFee *big.Int
+	}{
+		{
+			name:        "percentiles of one block",
+			blocks:      [][]int64{{10, 1, 9, 2, 8, 3, 7, 4, 6, 5}},
+			expected:    [3]int64{1, 5, 9},
+			nextBaseFee: big.NewInt(93_750_000_000),
+		},
+		{
+			name:        "median of blocks",
+			blocks:      [][]int64{{1}, {5}, {3}},
+			expected:    [3]int64{3, 3, 3},
+			nextBaseFee: big.NewInt(93_750_000_000),
+		},
+		{
+			name:        "empty blocks are not sampled",
+			blocks:      [][]int64{{2}, {}},
+			expected:    [3]int64{2, 2, 2},
+			nextBaseFee: big.NewInt(93_750_000_000),
+		},
+		{
+			name:        "no transactions",
+			blocks:      [][]int64{{}},
+			expected:    [3]int64{0, 0, 0},
+			nextBaseFee: big.NewInt(93_750_000_000),
+		},
+		{
+			name: "only recent blocks",
+			blocks: [][]int64{{1}, {2}, {3}, {4}, {5}, {6}, {7}, {8}, {9}, {10}, {11}, {12}, {13},
+				{14}, {15}, {16}, {17}, {18}, {19}, {20}, {21}, {22}, {23}, {24}, {25}},
+			expected:    [3]int64{16, 16, 16},
+			nextBaseFee: big.NewInt(93_750_000_000),
+		},
+		{
+			name:        "before London of the chain config",
+			chainConfig: params.MainnetChainConfig,
+			blocks:      [][]int64{{1}},
+			expected:    [3]int64{1, 1, 1},
+			nextBaseFee: big.NewInt(params.InitialBaseFee),
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			oracle := NewGasOracle(test.chainConfig)
+			for i, tips := range test.blocks {
+				oracle.AddBlock(gasBlock(int64(i+1), 100, tips...))
+			}
+			estimate := oracle.Estimate()
+			if estimate == nil {
+				t.Fatal("expected an estimate")
+			}
+			if estimate.Block != uint64(len(test.blocks)) || estimate.BaseFee.Cmp(gwei(100)) != 0 {
+				t.Errorf("expected block %d with base fee %s, got block %d with base fee %s",
+					len(test.blocks), gwei(100), estimate.Block, estimate.BaseFee)
+			}
+			if estimate.NextBaseFee.Cmp(test.nextBaseFee) != 0 {
+				t.Errorf("expected next base fee %s, got %s", test.nextBaseFee, estimate.NextBaseFee)
+			}
+			for i, speed := range gasSpeeds {
+				suggestion := estimate.Suggestions[speed.name]
+				tip := gwei(test.expected[i])
+				maxFee := new(big.Int).Add(new(big.Int).Mul(test.nextBaseFee, big.NewInt(2)), tip)
+				if suggestion.MaxPriorityFee.Cmp(tip) != 0 || suggestion.MaxFee.Cmp(maxFee) != 0 {
+					t.Errorf("expected %s tip %s and max fee %s, got tip %s and max fee %s",
+						speed.name, tip, maxFee, suggestion.MaxPriorityFee, suggestion.MaxFee)
+				}
+			}
+		})
+	}
+}
+
+func TestAddBlockBeforeLondon(t *testing.T) {
+	oracle := NewGasOracle(nil)
+	block := gasBlock(1, 100, 1)
+	header := block.Header()
+	header.BaseFee = nil
+	oracle.AddBlock(types.NewBlockWithHeader(header))
+	if estimate := oracle.Estimate(); estimate != nil {
+		t.Errorf("expected no estimate without a base fee, got %+v", estimate)
+	}
+}
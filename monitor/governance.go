@@ -0,0 +1,254 @@
+package monitor
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/event"
+	"hw-3/proxy"
+)
+
+type aggregatorState struct {
+	current  common.Address
+	proposed common.Address
+}
+
+func (m *Monitor) fetchAggregatorState(ctx context.Context, source feedSource) (aggregatorState, error) {
+	opts, cancel := m.callOpts(ctx)
+	defer cancel()
+
+	current, err := source.aggregator(opts)
+	if err != nil {
+		return aggregatorState{}, err
+	}
+	proposed, err := source.proposedAggregator(opts)
+	if err != nil {
+		return aggregatorState{}, err
+	}
+	return aggregatorState{current: current, proposed: proposed}, nil
+}
+
+func compareAggregatorState(feed Feed, prev, next aggregatorState) []Event {
+	var events []Event
+	if prev.proposed != next.proposed && next.proposed != (common.Address{}) {
+		events = append(events, &GovernanceAlert{
+			Feed: feed,
+			Kind: AggregatorProposed,
+			From: next.current,
+			To:   next.proposed,
+		})
+	}
+	if prev.current != next.current {
+		events = append(events, &GovernanceAlert{
+			Feed: feed,
+			Kind: AggregatorConfirmed,
+			From: prev.current,
+			To:   next.current,
+		})
+	} else if prev.proposed != (common.Address{}) && next.proposed == (common.Address{}) {
+		events = append(events, &GovernanceAlert{
+			Feed: feed,
+			Kind: ProposalWithdrawn,
+			From: next.current,
+			To:   prev.proposed,
+		})
+	}
+	return events
+}
+
+// registryName is the name ownership alerts of the Feed Registry are reported
+// for.
+const registryName = "Feed Registry"
+
+// ownershipEvents delivers the ownership events of a proxy or of the Feed
+// Registry, which emits the same events.
+type ownershipEvents struct {
+	requested   chan *proxy.ProxyOwnershipTransferRequested
+	transferred chan *proxy.ProxyOwnershipTransferred
+	subs        []event.Subscription
+}
+
+// subscribeOwnership subscribes to the ownership events of the contract at
+// feed.Address. Failures are reported for feed and return nil.
+func (m *Monitor) subscribeOwnership(ctx context.Context, feed Feed) *ownershipEvents {
+	proxyInstance, err := proxy.NewProxy(feed.Address, m.backend)
+	if err != nil {
+		m.feedError(ctx, feed, "Failed acquiring proxy instance", err)
+		return nil
+	}
+	owners := &ownershipEvents{
+		requested:   make(chan *proxy.ProxyOwnershipTransferRequested),
+		transferred: make(chan *proxy.ProxyOwnershipTransferred),
+	}
+
+	requestedSub, err := proxyInstance.WatchOwnershipTransferRequested(nil, owners.requested, nil, nil)
+	if err != nil {
+		m.feedError(ctx, feed, "Failed subscribing to ownership transfer requests", err)
+		return nil
+	}
+	owners.subs = append(owners.subs, resubscribe(requestedSub,
+		func(subCtx context.Context) (ethereum.Subscription, error) {
+			return proxyInstance.WatchOwnershipTransferRequested(&bind.WatchOpts{Context: subCtx}, owners.requested, nil, nil)
+		},
+		func(err error) { m.feedError(ctx, feed, "Failed while listening for ownership transfer requests", err) },
+		func(err error) { m.feedError(ctx, feed, "Failed resubscribing to ownership transfer requests", err) }))
+
+	transferredSub, err := proxyInstance.WatchOwnershipTransferred(nil, owners.transferred, nil, nil)
+	if err != nil {
+		owners.Unsubscribe()
+		m.feedError(ctx, feed, "Failed subscribing to ownership transfers", err)
+		return nil
+	}
+	owners.subs = append(owners.subs, resubscribe(transferredSub,
+		func(subCtx context.Context) (ethereum.Subscription, error) {
+			return proxyInstance.WatchOwnershipTransferred(&bind.WatchOpts{Context: subCtx}, owners.transferred, nil, nil)
+		},
+		func(err error) { m.feedError(ctx, feed, "Failed while listening for ownership transfers", err) },
+		func(err error) { m.feedError(ctx, feed, "Failed resubscribing to ownership transfers", err) }))
+	return owners
+}
+
+func (o *ownershipEvents) Unsubscribe() {
+	for _, sub := range o.subs {
+		sub.Unsubscribe()
+	}
+}
+
+func ownershipRequested(feed Feed, ev *proxy.ProxyOwnershipTransferRequested) *GovernanceAlert {
+	return &GovernanceAlert{
+		Feed:   feed,
+		Kind:   OwnershipTransferRequested,
+		From:   ev.From,
+		To:     ev.To,
+		TxHash: ev.Raw.TxHash,
+	}
+}
+
+func ownershipTransferred(feed Feed, ev *proxy.ProxyOwnershipTransferred) *GovernanceAlert {
+	return &GovernanceAlert{
+		Feed:   feed,
+		Kind:   OwnershipTransferred,
+		From:   ev.From,
+		To:     ev.To,
+		TxHash: ev.Raw.TxHash,
+	}
+}
+
+// registries returns the Feed Registries of feeds, each once.
+func registries(feeds []Feed) []common.Address {
+	seen := make(map[common.Address]bool)
+	var addresses []common.Address
+	for _, feed := range feeds {
+		if feed.Registry() && !seen[feed.Address] {
+			seen[feed.Address] = true
+			addresses = append(addresses, feed.Address)
+		}
+	}
+	return addresses
+}
+
+// watchRegistryOwnership alerts on ownership changes of a Feed Registry, which
+// are watched once for all feeds resolved through it.
+func (m *Monitor) watchRegistryOwnership(ctx context.Context, registry common.Address, wg *sync.WaitGroup) {
+	defer wg.Done()
+
+	feed := Feed{Tokens: registryName, Address: registry}
+	owners := m.subscribeOwnership(ctx, feed)
+	if owners == nil {
+		return
+	}
+	defer owners.Unsubscribe()
+
+	m.emit(ctx, &Subscribed{
+		Kind:    SubscriptionOwnership,
+		Name:    registryName,
+		Address: registry,
+	})
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case ev := <-owners.requested:
+			m.emit(ctx, ownershipRequested(feed, ev))
+		case ev := <-owners.transferred:
+			m.emit(ctx, ownershipTransferred(feed, ev))
+		}
+	}
+}
+
+// monitorGovernance polls the aggregator of feed and alerts on ownership
+// changes of its proxy. The ownership of the Feed Registry is watched by
+// watchRegistryOwnership instead.
+func (m *Monitor) monitorGovernance(ctx context.Context, feed Feed, wg *sync.WaitGroup) {
+	defer wg.Done()
+
+	source, err := newFeedSource(feed, m.backend)
+	if err != nil {
+		m.feedError(ctx, feed, "Failed acquiring feed source", err)
+		return
+	}
+
+	state, err := m.fetchAggregatorState(ctx, source)
+	if err != nil {
+		m.feedError(ctx, feed, "Failed acquiring aggregator state", err)
+		return
+	}
+	if state.proposed != (common.Address{}) {
+		m.emit(ctx, &GovernanceAlert{
+			Feed: feed,
+			Kind: AggregatorProposed,
+			From: state.current,
+			To:   state.proposed,
+		})
+		m.compareProposedAggregator(ctx, source, feed, state)
+	}
+
+	// Without a proxy the ownership channels stay nil and never deliver
+	var requested chan *proxy.ProxyOwnershipTransferRequested
+	var transferred chan *proxy.ProxyOwnershipTransferred
+	if !feed.Registry() {
+		owners := m.subscribeOwnership(ctx, feed)
+		if owners == nil {
+			return
+		}
+		defer owners.Unsubscribe()
+		requested, transferred = owners.requested, owners.transferred
+	}
+
+	ticker := time.NewTicker(m.config.PollInterval)
+	defer ticker.Stop()
+
+	m.emit(ctx, &Subscribed{
+		Kind:       SubscriptionGovernance,
+		Name:       feed.Tokens,
+		Address:    feed.Address,
+		Aggregator: state.current,
+	})
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case ev := <-requested:
+			m.emit(ctx, ownershipRequested(feed, ev))
+		case ev := <-transferred:
+			m.emit(ctx, ownershipTransferred(feed, ev))
+		case <-ticker.C:
+			next, err := m.fetchAggregatorState(ctx, source)
+			if err != nil {
+				m.feedError(ctx, feed, "Failed acquiring aggregator state", err)
+				continue
+			}
+			for _, ev := range compareAggregatorState(feed, state, next) {
+				m.emit(ctx, ev)
+			}
+			state = next
+			if state.proposed != (common.Address{}) {
+				m.compareProposedAggregator(ctx, source, feed, state)
+			}
+		}
+	}
+}
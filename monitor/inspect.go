@@ -0,0 +1,218 @@
+package monitor
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sort"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"hw-3/aggregator"
+	"hw-3/proxy"
+)
+
+const defaultCadenceRounds = 20
+
+// Phase is one of the aggregators a proxy has pointed to. Round ids are those
+// of the aggregator, the proxy reports them with the phase in the upper bits.
+type Phase struct {
+	ID             uint16
+	Aggregator     common.Address
+	FirstRound     *big.Int
+	FirstUpdatedAt time.Time
+	LastRound      *big.Int
+	LastUpdatedAt  time.Time
+	// Err is set if the aggregator could not be queried, e.g. because an old
+	// aggregator does not implement the current interface.
+	Err error
+}
+
+// OwnershipTransfer is a completed transfer of the ownership of a proxy.
+type OwnershipTransfer struct {
+	From   common.Address
+	To     common.Address
+	Block  uint64
+	TxHash common.Hash
+}
+
+// Cadence summarizes the intervals between the updates of recent rounds.
+type Cadence struct {
+	Rounds int
+	Min    time.Duration
+	Max    time.Duration
+	Mean   time.Duration
+	Median time.Duration
+}
+
+// Inspection is everything known about a feed, see Inspect.
+type Inspection struct {
+	Address            common.Address
+	Description        string
+	Decimals           uint8
+	Version            *big.Int
+	Owner              common.Address
+	Aggregator         common.Address
+	ProposedAggregator common.Address
+	Latest             *Round
+	Phases             []Phase
+	Transfers          []OwnershipTransfer
+	// Cadence is nil if fewer than two recent rounds were answered.
+	Cadence *Cadence
+}
+
+// InspectOptions limits the history Inspect looks at. Ownership transfers
+// are searched from FromBlock on, the cadence is computed from the last
+// CadenceRounds rounds, zero means 20.
+type InspectOptions struct {
+	FromBlock     uint64
+	CadenceRounds int
+}
+
+// Inspect queries the metadata, phase aggregators, ownership history and
+// update cadence of the feed whose proxy is at address.
+func Inspect(ctx context.Context, backend bind.ContractBackend, address common.Address, options InspectOptions) (*Inspection, error) {
+	if options.CadenceRounds <= 0 {
+		options.CadenceRounds = defaultCadenceRounds
+	}
+	proxyInstance, err := proxy.NewProxy(address, backend)
+	if err != nil {
+		return nil, fmt.Errorf("failed acquiring proxy instance: %w", err)
+	}
+	opts := &bind.CallOpts{Context: ctx}
+
+	snapshot, err := QuerySnapshot(ctx, backend, Feed{Address: address})
+	if err != nil {
+		return nil, err
+	}
+	inspection := &Inspection{
+		Address:     address,
+		Description: snapshot.Description,
+		Decimals:    snapshot.Decimals,
+		Version:     snapshot.Version,
+		Latest:      &snapshot.Round,
+	}
+	if inspection.Owner, err = proxyInstance.Owner(opts); err != nil {
+		return nil, fmt.Errorf("failed querying owner: %w", err)
+	}
+	if inspection.Aggregator, err = proxyInstance.Aggregator(opts); err != nil {
+		return nil, fmt.Errorf("failed querying aggregator: %w", err)
+	}
+	if inspection.ProposedAggregator, err = proxyInstance.ProposedAggregator(opts); err != nil {
+		return nil, fmt.Errorf("failed querying proposed aggregator: %w", err)
+	}
+
+	for id := uint16(1); id <= snapshot.PhaseID; id++ {
+		phaseAggregator, err := proxyInstance.PhaseAggregators(opts, id)
+		if err != nil {
+			return nil, fmt.Errorf("failed querying aggregator of phase %d: %w", id, err)
+		}
+		inspection.Phases = append(inspection.Phases, inspectPhase(opts, backend, id, phaseAggregator))
+	}
+
+	if inspection.Transfers, err = ownershipTransfers(ctx, proxyInstance, options.FromBlock); err != nil {
+		return nil, err
+	}
+	if inspection.Cadence, err = updateCadence(opts, backend, inspection.Aggregator, options.CadenceRounds); err != nil {
+		return nil, err
+	}
+	return inspection, nil
+}
+
+func inspectPhase(opts *bind.CallOpts, backend bind.ContractBackend, id uint16, address common.Address) Phase {
+	phase := Phase{ID: id, Aggregator: address}
+	aggregatorCaller, err := aggregator.NewAggregatorCaller(address, backend)
+	if err != nil {
+		phase.Err = err
+		return phase
+	}
+	// Rounds of both Flux Monitor and OCR aggregators are numbered from 1
+	phase.FirstRound = big.NewInt(1)
+	if phase.LastRound, err = aggregatorCaller.LatestRound(opts); err != nil {
+		phase.Err = fmt.Errorf("failed querying latest round: %w", err)
+		return phase
+	}
+	if phase.LastRound.Sign() == 0 {
+		phase.FirstRound = nil
+		return phase
+	}
+	first, err := aggregatorCaller.GetTimestamp(opts, phase.FirstRound)
+	if err != nil {
+		phase.Err = fmt.Errorf("failed querying first round: %w", err)
+		return phase
+	}
+	last, err := aggregatorCaller.LatestTimestamp(opts)
+	if err != nil {
+		phase.Err = fmt.Errorf("failed querying latest timestamp: %w", err)
+		return phase
+	}
+	phase.FirstUpdatedAt = time.Unix(first.Int64(), 0)
+	phase.LastUpdatedAt = time.Unix(last.Int64(), 0)
+	return phase
+}
+
+func ownershipTransfers(ctx context.Context, proxyInstance *proxy.Proxy, fromBlock uint64) ([]OwnershipTransfer, error) {
+	iterator, err := proxyInstance.FilterOwnershipTransferred(&bind.FilterOpts{Start: fromBlock, Context: ctx}, nil, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed filtering ownership transfers: %w", err)
+	}
+	defer iterator.Close()
+
+	var transfers []OwnershipTransfer
+	for iterator.Next() {
+		transfers = append(transfers, OwnershipTransfer{
+			From:   iterator.Event.From,
+			To:     iterator.Event.To,
+			Block:  iterator.Event.Raw.BlockNumber,
+			TxHash: iterator.Event.Raw.TxHash,
+		})
+	}
+	if err := iterator.Error(); err != nil {
+		return nil, fmt.Errorf("failed filtering ownership transfers: %w", err)
+	}
+	return transfers, nil
+}
+
+// updateCadence measures the intervals between the last rounds of the
+// current aggregator, rounds that were never answered are skipped.
+func updateCadence(opts *bind.CallOpts, backend bind.ContractBackend, address common.Address, rounds int) (*Cadence, error) {
+	aggregatorCaller, err := aggregator.NewAggregatorCaller(address, backend)
+	if err != nil {
+		return nil, fmt.Errorf("failed acquiring aggregator instance: %w", err)
+	}
+	latest, err := aggregatorCaller.LatestRound(opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed querying latest round: %w", err)
+	}
+
+	var timestamps []int64
+	for roundID := new(big.Int).Set(latest); roundID.Sign() > 0 && len(timestamps) < rounds; roundID.Sub(roundID, big.NewInt(1)) {
+		updatedAt, err := aggregatorCaller.GetTimestamp(opts, roundID)
+		if err != nil {
+			return nil, fmt.Errorf("failed querying round %s: %w", roundID, err)
+		}
+		if updatedAt.Sign() > 0 {
+			timestamps = append(timestamps, updatedAt.Int64())
+		}
+	}
+	if len(timestamps) < 2 {
+		return nil, nil
+	}
+
+	intervals := make([]time.Duration, 0, len(timestamps)-1)
+	total := time.Duration(0)
+	for i := 1; i < len(timestamps); i++ {
+		interval := time.Duration(timestamps[i-1]-timestamps[i]) * time.Second
+		intervals = append(intervals, interval)
+		total += interval
+	}
+	sort.Slice(intervals, func(i, j int) bool { return intervals[i] < intervals[j] })
+	return &Cadence{
+		Rounds: len(timestamps),
+		Min:    intervals[0],
+		Max:    intervals[len(intervals)-1],
+		Mean:   total / time.Duration(len(intervals)),
+		Median: intervals[len(intervals)/2],
+	}, nil
+}
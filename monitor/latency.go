@@ -0,0 +1,116 @@
+package monitor
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// blockHeadersSize is the number of recent block headers kept.
+const blockHeadersSize = 256
+
+// blockHeaders keeps the headers of recent blocks, so that price updates
+// don't need to query the block including them.
+type blockHeaders struct {
+	mu      sync.RWMutex
+	headers map[common.Hash]*types.Header
+	hashes  []common.Hash
+	latest  time.Time
+}
+
+func newBlockHeaders() *blockHeaders {
+	return &blockHeaders{headers: make(map[common.Hash]*types.Header)}
+}
+
+func (b *blockHeaders) set(hash common.Hash, header *types.Header) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if _, ok := b.headers[hash]; ok {
+		return
+	}
+	if len(b.hashes) == blockHeadersSize {
+		delete(b.headers, b.hashes[0])
+		b.hashes = b.hashes[1:]
+	}
+	b.headers[hash] = header
+	b.hashes = append(b.hashes, hash)
+	if blockTime := time.Unix(int64(header.Time), 0); blockTime.After(b.latest) {
+		b.latest = blockTime
+	}
+}
+
+// latestTime returns the latest timestamp of the blocks kept, zero if none
+// was observed yet.
+func (b *blockHeaders) latestTime() time.Time {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	return b.latest
+}
+
+func (b *blockHeaders) get(hash common.Hash) (*types.Header, bool) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	header, ok := b.headers[hash]
+	return header, ok
+}
+
+// blockHeader returns the header of the block with the given hash and number,
+// queried from the backend unless the block was already observed.
+func (m *Monitor) blockHeader(ctx context.Context, hash common.Hash, number uint64) (*types.Header, error) {
+	if header, ok := m.blocks.get(hash); ok {
+		return header, nil
+	}
+	timeoutCtx, cancel := context.WithTimeout(ctx, m.config.QueryTimeout)
+	defer cancel()
+	var header *types.Header
+	if blockBackend, ok := m.backend.(BlockBackend); ok {
+		block, err := blockBackend.BlockByHash(timeoutCtx, hash)
+		if err != nil {
+			return nil, err
+		}
+		header = block.Header()
+	} else {
+		var err error
+		header, err = m.backend.HeaderByNumber(timeoutCtx, new(big.Int).SetUint64(number))
+		if err != nil {
+			return nil, err
+		}
+		if header.Hash() != hash {
+			return nil, fmt.Errorf("block %d was replaced", number)
+		}
+	}
+	m.blocks.set(hash, header)
+	return header, nil
+}
+
+// blockTime returns the timestamp of the block including vLog.
+func (m *Monitor) blockTime(ctx context.Context, vLog types.Log) (time.Time, error) {
+	header, err := m.blockHeader(ctx, vLog.BlockHash, vLog.BlockNumber)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return time.Unix(int64(header.Time), 0), nil
+}
+
+// BlockLatency is the time from the oracle network updating the round to the
+// block including the update, zero if the block time is unknown.
+func (u *PriceUpdate) BlockLatency() time.Duration {
+	if u.BlockTime.IsZero() {
+		return 0
+	}
+	return u.BlockTime.Sub(u.UpdatedAt)
+}
+
+// ReceiveLatency is the time from the block including the update to the
+// monitor receiving it, zero if the block time is unknown.
+func (u *PriceUpdate) ReceiveLatency() time.Duration {
+	if u.BlockTime.IsZero() {
+		return 0
+	}
+	return u.ReceivedAt.Sub(u.BlockTime)
+}
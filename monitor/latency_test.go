@@ -0,0 +1,35 @@
+package monitor_test
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"hw-3/monitor"
+)
+
+func TestUpdateLatency(t *testing.T) {
+	node, events := scriptedNode(t, scriptedFeed())
+
+	block := node.Mine()
+	update := answerUpdated(t, block, 1234_00000000, 1)
+	// The oracle network updated the round 30 seconds before the block
+	update.Data = common.BigToHash(new(big.Int).SetUint64(block.Time - 30)).Bytes()
+	sent := time.Now()
+	node.PushLogs(update)
+
+	price := nextEvent[*monitor.PriceUpdate](t, events)
+	if expected := time.Unix(int64(block.Time), 0); !price.BlockTime.Equal(expected) {
+		t.Errorf("expected block time %s, got %s", expected, price.BlockTime)
+	}
+	if latency := price.BlockLatency(); latency != 30*time.Second {
+		t.Errorf("expected block latency of 30s, got %s", latency)
+	}
+	if price.ReceivedAt.Before(sent) || price.ReceivedAt.After(time.Now()) {
+		t.Errorf("expected update received after %s, got %s", sent, price.ReceivedAt)
+	}
+	if latency := price.ReceiveLatency(); latency != price.ReceivedAt.Sub(price.BlockTime) {
+		t.Errorf("expected receive latency of %s, got %s", price.ReceivedAt.Sub(price.BlockTime), latency)
+	}
+}
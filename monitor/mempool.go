@@ -0,0 +1,270 @@
+package monitor
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"hw-3/decimal"
+)
+
+const (
+	// mempoolWorkers is the number of pending transactions looked up at once.
+	mempoolWorkers = 16
+	// mempoolQueueSize is the number of pending transactions waiting to be
+	// looked up, more are skipped until the workers catch up.
+	mempoolQueueSize = 4096
+)
+
+var (
+	errNoPendingBackend = errors.New("backend doesn't support subscribing to pending transactions")
+	errEmptyReport      = errors.New("report has no valid observations")
+	errMempoolBehind    = errors.New("too many pending transactions waiting to be looked up")
+
+	transmitMethod = ocrABI.Methods["transmit"]
+	// reportArgs is the layout of OCR reports: the report context, the
+	// indices of the observers and their sorted observations.
+	reportArgs = abi.Arguments{
+		{Type: mustNewType("bytes32")},
+		{Type: mustNewType("bytes32")},
+		{Type: mustNewType("int192[]")},
+	}
+)
+
+func mustNewType(name string) abi.Type {
+	typ, err := abi.NewType(name, "", nil)
+	if err != nil {
+		panic(err)
+	}
+	return typ
+}
+
+// PendingBackend is implemented by backends able to stream the hashes of
+// pending transactions and look them up.
+type PendingBackend interface {
+	SubscribePendingTransactions(ctx context.Context, ch chan<- common.Hash) (ethereum.Subscription, error)
+	TransactionByHash(ctx context.Context, hash common.Hash) (*types.Transaction, bool, error)
+}
+
+// pendingTarget is a watched aggregator whose transmissions are looked for in
+// the mempool.
+type pendingTarget struct {
+	feed     Feed
+	decimals uint8
+}
+
+// pendingTargets keeps the aggregators currently watched by feeds. Feeds can
+// share an aggregator, e.g. a proxy and its Feed Registry entry, and a feed
+// can briefly watch it twice while its watcher restarts, so every
+// registration is kept until it is removed.
+type pendingTargets struct {
+	mu      sync.RWMutex
+	targets map[common.Address]map[*pendingTarget]struct{}
+}
+
+func newPendingTargets() *pendingTargets {
+	return &pendingTargets{targets: make(map[common.Address]map[*pendingTarget]struct{})}
+}
+
+// add registers target for transmissions to aggregator until remove is
+// called.
+func (p *pendingTargets) add(aggregator common.Address, target pendingTarget) (remove func()) {
+	registered := &target
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.targets[aggregator] == nil {
+		p.targets[aggregator] = make(map[*pendingTarget]struct{})
+	}
+	p.targets[aggregator][registered] = struct{}{}
+	return func() {
+		p.mu.Lock()
+		defer p.mu.Unlock()
+		delete(p.targets[aggregator], registered)
+		if len(p.targets[aggregator]) == 0 {
+			delete(p.targets, aggregator)
+		}
+	}
+}
+
+// get returns the targets of the feeds watching aggregator, once per feed.
+func (p *pendingTargets) get(aggregator common.Address) []pendingTarget {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	var targets []pendingTarget
+	seen := make(map[Feed]bool, len(p.targets[aggregator]))
+	for target := range p.targets[aggregator] {
+		if !seen[target.feed] {
+			seen[target.feed] = true
+			targets = append(targets, *target)
+		}
+	}
+	return targets
+}
+
+// decodeTransmit decodes the report carried by the input of an OCR transmit
+// call.
+func decodeTransmit(input []byte) (observers []int, observations []*big.Int, err error) {
+	args, err := transmitMethod.Inputs.Unpack(input[len(transmitMethod.ID):])
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed decoding transmit call: %w", err)
+	}
+	report, err := reportArgs.Unpack(args[0].([]byte))
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed decoding report: %w", err)
+	}
+	rawObservers := report[1].([32]byte)
+	observations = report[2].([]*big.Int)
+	if len(observations) == 0 || len(observations) > len(rawObservers) {
+		return nil, nil, errEmptyReport
+	}
+	observers = make([]int, 0, len(observations))
+	for _, observer := range rawObservers[:len(observations)] {
+		observers = append(observers, int(observer))
+	}
+	return observers, observations, nil
+}
+
+// newPendingPrice decodes the price a pending transmission to the aggregator
+// of target is about to report.
+func newPendingPrice(tx *types.Transaction, target pendingTarget, seenAt time.Time) (*PendingPrice, error) {
+	observers, observations, err := decodeTransmit(tx.Data())
+	if err != nil {
+		return nil, err
+	}
+	transmitter, err := types.Sender(types.LatestSignerForChainID(tx.ChainId()), tx)
+	if err != nil {
+		return nil, fmt.Errorf("failed recovering sender of %s: %w", tx.Hash(), err)
+	}
+	answer := observations[len(observations)/2]
+	return &PendingPrice{
+		Feed:        target.feed,
+		Aggregator:  *tx.To(),
+		TxHash:      tx.Hash(),
+		Transmitter: transmitter,
+		Answer:      answer,
+		Decimals:    target.decimals,
+		Price:       decimal.New(answer, target.decimals),
+		Observers:   observers,
+		SeenAt:      seenAt,
+	}, nil
+}
+
+// pendingHash is a pending transaction waiting to be looked up.
+type pendingHash struct {
+	hash   common.Hash
+	seenAt time.Time
+}
+
+// checkPending looks up a pending transaction and emits its price if it is a
+// transmission to a watched aggregator.
+func (m *Monitor) checkPending(ctx context.Context, pendingBackend PendingBackend, pending pendingHash) {
+	timeoutCtx, cancel := context.WithTimeout(ctx, m.config.QueryTimeout)
+	tx, isPending, err := pendingBackend.TransactionByHash(timeoutCtx, pending.hash)
+	cancel()
+	if errors.Is(err, ethereum.NotFound) || (err == nil && !isPending) {
+		// Dropped or already mined
+		return
+	}
+	if err != nil {
+		m.emit(ctx, &BlockError{Message: "Failed querying pending transaction", Err: err})
+		return
+	}
+	if tx.To() == nil || !bytes.HasPrefix(tx.Data(), transmitMethod.ID) {
+		return
+	}
+	for _, target := range m.aggregators.get(*tx.To()) {
+		price, err := newPendingPrice(tx, target, pending.seenAt)
+		if err != nil {
+			m.feedError(ctx, target.feed, "Failed decoding pending transmission", err)
+			continue
+		}
+		price.Unreliable = m.sequencer.unreliable(price.SeenAt)
+		m.emit(ctx, price)
+	}
+}
+
+// lookUpPending checks the queued pending transactions until ctx is
+// cancelled.
+func (m *Monitor) lookUpPending(ctx context.Context, pendingBackend PendingBackend, queue <-chan pendingHash, wg *sync.WaitGroup) {
+	defer wg.Done()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case pending := <-queue:
+			m.checkPending(ctx, pendingBackend, pending)
+		}
+	}
+}
+
+// watchMempool looks up every pending transaction and emits the price of
+// transmissions to watched aggregators before they are mined. Transactions
+// are looked up by mempoolWorkers at once, so the subscription keeps being
+// drained, and the number skipped while they fall behind is reported every
+// PollInterval.
+func (m *Monitor) watchMempool(ctx context.Context, wg *sync.WaitGroup) {
+	defer wg.Done()
+
+	pendingBackend, ok := m.backend.(PendingBackend)
+	if !ok {
+		m.emit(ctx, &BlockError{Message: "Failed subscribing to pending transactions", Err: errNoPendingBackend})
+		return
+	}
+
+	hashes := make(chan common.Hash)
+	sub, err := pendingBackend.SubscribePendingTransactions(ctx, hashes)
+	if err != nil {
+		m.emit(ctx, &BlockError{Message: "Failed subscribing to pending transactions", Err: err})
+		return
+	}
+	resub := resubscribe(sub,
+		func(subCtx context.Context) (ethereum.Subscription, error) {
+			return pendingBackend.SubscribePendingTransactions(subCtx, hashes)
+		},
+		func(err error) {
+			m.emit(ctx, &BlockError{Message: "Failed while listening for pending transactions", Err: err})
+		},
+		func(err error) {
+			m.emit(ctx, &BlockError{Message: "Failed resubscribing to pending transactions", Err: err})
+		})
+	defer resub.Unsubscribe()
+
+	queue := make(chan pendingHash, mempoolQueueSize)
+	workers := sync.WaitGroup{}
+	workers.Add(mempoolWorkers)
+	for i := 0; i < mempoolWorkers; i++ {
+		go m.lookUpPending(ctx, pendingBackend, queue, &workers)
+	}
+	defer workers.Wait()
+
+	ticker := time.NewTicker(m.config.PollInterval)
+	defer ticker.Stop()
+
+	m.emit(ctx, &Subscribed{Kind: SubscriptionPending})
+	skipped := 0
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case hash := <-hashes:
+			select {
+			case queue <- pendingHash{hash: hash, seenAt: time.Now()}:
+			default:
+				skipped++
+			}
+		case <-ticker.C:
+			if skipped > 0 {
+				m.emit(ctx, &BlockError{Message: fmt.Sprintf("Skipped %d pending transactions", skipped), Err: errMempoolBehind})
+				skipped = 0
+			}
+		}
+	}
+}
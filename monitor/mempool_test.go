@@ -0,0 +1,191 @@
+package monitor_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/ethclient/gethclient"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/rpc"
+	"hw-3/fakenode"
+	"hw-3/monitor"
+	"hw-3/ocr"
+	"hw-3/proxy"
+)
+
+var transmitterKey, _ = crypto.GenerateKey()
+
+// pendingClient adds the pending transaction subscription of the geth API to
+// ethclient.
+type pendingClient struct {
+	*ethclient.Client
+	geth *gethclient.Client
+}
+
+func (c *pendingClient) SubscribePendingTransactions(ctx context.Context, ch chan<- common.Hash) (ethereum.Subscription, error) {
+	return c.geth.SubscribePendingTransactions(ctx, ch)
+}
+
+// stalledPendingClient holds the lookup of the stalled transaction until
+// release is closed.
+type stalledPendingClient struct {
+	*pendingClient
+	stalled common.Hash
+	release chan struct{}
+}
+
+func (c *stalledPendingClient) TransactionByHash(ctx context.Context, hash common.Hash) (*types.Transaction, bool, error) {
+	if hash == c.stalled {
+		select {
+		case <-c.release:
+		case <-ctx.Done():
+			return nil, false, ctx.Err()
+		}
+	}
+	return c.pendingClient.TransactionByHash(ctx, hash)
+}
+
+func dialPending(t *testing.T, node *fakenode.Node) *pendingClient {
+	t.Helper()
+	rpcClient, err := rpc.DialContext(context.Background(), node.WebsocketURL())
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(rpcClient.Close)
+	return &pendingClient{Client: ethclient.NewClient(rpcClient), geth: gethclient.New(rpcClient)}
+}
+
+// transmitTx builds a signed transaction calling transmit on to with a report
+// of the given observations.
+func transmitTx(t *testing.T, to common.Address, nonce uint64, observers []byte, observations ...int64) *types.Transaction {
+	t.Helper()
+	var rawObservers [32]byte
+	copy(rawObservers[:], observers)
+	values := make([]*big.Int, 0, len(observations))
+	for _, observation := range observations {
+		values = append(values, big.NewInt(observation))
+	}
+	bytes32, _ := abi.NewType("bytes32", "", nil)
+	int192s, _ := abi.NewType("int192[]", "", nil)
+	report, err := abi.Arguments{{Type: bytes32}, {Type: bytes32}, {Type: int192s}}.Pack([32]byte{}, rawObservers, values)
+	if err != nil {
+		t.Fatal(err)
+	}
+	input, err := parseABI(t, ocr.OffchainAggregatorMetaData.ABI).Pack("transmit", report, [][32]byte{}, [][32]byte{}, [32]byte{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	tx, err := types.SignNewTx(transmitterKey, types.LatestSignerForChainID(params.MainnetChainConfig.ChainID), &types.DynamicFeeTx{
+		ChainID:   params.MainnetChainConfig.ChainID,
+		Nonce:     nonce,
+		To:        &to,
+		Gas:       500_000,
+		GasFeeCap: big.NewInt(params.GWei * 50),
+		GasTipCap: big.NewInt(params.GWei),
+		Data:      input,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return tx
+}
+
+func TestPendingPrice(t *testing.T) {
+	node := feedNode(t)
+	events := startMonitor(t, dialPending(t, node), monitor.Config{
+		Feeds:   scriptedFeed().Feeds,
+		Mempool: true,
+	})
+
+	// Transmissions to other contracts are ignored
+	node.PushPendingTransaction(transmitTx(t, common.Address{1}, 0, []byte{0}, 1_00000000))
+	node.PushPendingTransaction(transmitTx(t, aggregatorAddress, 1, []byte{3, 0, 7, 1}, 1990_00000000, 1999_00000000, 2000_00000000, 2010_00000000))
+
+	pending := nextEvent[*monitor.PendingPrice](t, events)
+	if pending.Aggregator != aggregatorAddress || pending.Feed.Address != feedAddress {
+		t.Errorf("expected pending price of %s at %s, got %s at %s", feedAddress, aggregatorAddress, pending.Feed.Address, pending.Aggregator)
+	}
+	if got := pending.Price.String(); got != "2000.00000000" {
+		t.Errorf("expected price 2000.00000000, got %s", got)
+	}
+	if expected := crypto.PubkeyToAddress(transmitterKey.PublicKey); pending.Transmitter != expected {
+		t.Errorf("expected transmitter %s, got %s", expected, pending.Transmitter)
+	}
+	if len(pending.Observers) != 4 || pending.Observers[0] != 3 || pending.Observers[2] != 7 {
+		t.Errorf("unexpected observers %v", pending.Observers)
+	}
+	if pending.Unreliable {
+		t.Error("expected pending price to be reliable without a sequencer uptime feed")
+	}
+}
+
+func TestPendingPriceSequencerDown(t *testing.T) {
+	node := feedNode(t)
+	setSequencer(t, node, false, time.Now().Add(-time.Hour))
+	events := startMonitor(t, dialPending(t, node), monitor.Config{
+		Feeds:               scriptedFeed().Feeds,
+		Mempool:             true,
+		SequencerUptimeFeed: uptimeAddress,
+	})
+
+	node.PushPendingTransaction(transmitTx(t, aggregatorAddress, 1, []byte{0}, 2000_00000000))
+	if pending := nextEvent[*monitor.PendingPrice](t, events); !pending.Unreliable {
+		t.Error("expected pending price while the sequencer is down to be unreliable")
+	}
+}
+
+func TestPendingPriceSharedAggregator(t *testing.T) {
+	node := feedNode(t)
+	secondProxy := common.HexToAddress("0x0000000000000000000000000000000000000f2d")
+	proxyABI := parseABI(t, proxy.ProxyMetaData.ABI)
+	for _, err := range []error{
+		node.SetCallResult(secondProxy, proxyABI, "aggregator", aggregatorAddress),
+		node.SetCallResult(secondProxy, proxyABI, "proposedAggregator", common.Address{}),
+		node.SetCallResult(secondProxy, proxyABI, "phaseId", uint16(1)),
+	} {
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	events := startMonitor(t, dialPending(t, node), monitor.Config{
+		Feeds: []monitor.Feed{
+			{Tokens: "ETH / USD", Address: feedAddress},
+			{Tokens: "ETH / USD 2", Address: secondProxy},
+		},
+		Mempool: true,
+	})
+
+	node.PushPendingTransaction(transmitTx(t, aggregatorAddress, 0, []byte{0}, 2000_00000000))
+	feeds := map[common.Address]bool{}
+	for i := 0; i < 2; i++ {
+		feeds[nextEvent[*monitor.PendingPrice](t, events).Feed.Address] = true
+	}
+	if !feeds[feedAddress] || !feeds[secondProxy] {
+		t.Errorf("expected pending prices of %s and %s, got %v", feedAddress, secondProxy, feeds)
+	}
+}
+
+func TestPendingPriceSlowLookup(t *testing.T) {
+	node := feedNode(t)
+	stalled := transmitTx(t, common.Address{1}, 0, []byte{0}, 1_00000000)
+	client := &stalledPendingClient{pendingClient: dialPending(t, node), stalled: stalled.Hash(), release: make(chan struct{})}
+	defer close(client.release)
+	events := startMonitor(t, client, monitor.Config{
+		Feeds:   scriptedFeed().Feeds,
+		Mempool: true,
+	})
+
+	node.PushPendingTransaction(stalled)
+	node.PushPendingTransaction(transmitTx(t, aggregatorAddress, 1, []byte{0}, 2000_00000000))
+	if got := nextEvent[*monitor.PendingPrice](t, events).Price.String(); got != "2000.00000000" {
+		t.Errorf("expected price 2000.00000000, got %s", got)
+	}
+}
@@ -0,0 +1,315 @@
+// Package monitor watches Chainlink price feeds and new blocks and reports
+// everything it observes as a stream of typed events.
+package monitor
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+const (
+	defaultQueryTimeout = 30 * time.Second
+	eventsBufSize       = 256
+	defaultPollInterval = 15 * time.Second
+	resubscribeBackoff  = 5 * time.Second
+)
+
+var errNoBlockBackend = errors.New("backend does not support block subscriptions")
+
+// Feed is a Chainlink price feed identified by the address of its proxy or,
+// if Base is set, by its base and quote assets in the Feed Registry at
+// Address. If Name is set, Address is the proxy the ENS name resolves to and
+// is kept up to date by the Monitor.
+type Feed struct {
+	Tokens  string
+	Address common.Address
+	Name    string
+	Base    common.Address
+	Quote   common.Address
+}
+
+// Registry reports whether the feed is resolved through the Feed Registry.
+func (f Feed) Registry() bool {
+	return f.Base != (common.Address{})
+}
+
+// Contract is an arbitrary contract whose events are decoded with its ABI.
+// Events are the names of the events watched, all events of the ABI if empty.
+type Contract struct {
+	Name    string
+	Address common.Address
+	ABI     abi.ABI
+	Events  []string
+}
+
+// Network is another network divergence group members can be read from. On
+// L2 networks SequencerUptimeFeed is its sequencer uptime feed.
+type Network struct {
+	Backend             bind.ContractCaller
+	SequencerUptimeFeed common.Address
+}
+
+// Config lists everything the Monitor watches. PollInterval is how often
+// state without events, e.g. the proposed aggregator, is queried, zero means
+// every 15 seconds. QueryTimeout limits every request to the backend, zero
+// means 30 seconds. On L2 networks SequencerUptimeFeed is the sequencer
+// uptime feed, prices are marked unreliable while the sequencer is down and
+// for SequencerGracePeriod after it comes back up, zero means an hour.
+// UpdateCosts looks up the transaction of every price update to report its
+// cost and Mempool watches pending transactions for OCR transmissions to the
+// aggregators of the feeds. DEXPools are compared with their feeds on every
+// block and the members of DivergenceGroups with each other every
+// PollInterval. Networks are other networks by name, group members on them
+// are read from there. ChainConfig is the chain config of
+// the network used to predict base fees, nil assumes London is active on
+// every block with a base fee.
+type Config struct {
+	Feeds                []Feed
+	Contracts            []Contract
+	DEXPools             []DEXPool
+	DivergenceGroups     []DivergenceGroup
+	Networks             map[string]Network
+	UpdateCosts          bool
+	Mempool              bool
+	PollInterval         time.Duration
+	QueryTimeout         time.Duration
+	SequencerUptimeFeed  common.Address
+	SequencerGracePeriod time.Duration
+	ChainConfig          *params.ChainConfig
+}
+
+// BlockBackend is implemented by backends able to stream new blocks,
+// e.g. *ethclient.Client or the simulated backend.
+type BlockBackend interface {
+	SubscribeNewHead(ctx context.Context, ch chan<- *types.Header) (ethereum.Subscription, error)
+	BlockByHash(ctx context.Context, hash common.Hash) (*types.Block, error)
+}
+
+// Monitor subscribes to blocks and feed events and emits them to the
+// channel returned by Events.
+type Monitor struct {
+	config      Config
+	backend     bind.ContractBackend
+	gas         *GasOracle
+	latest      *latestPrices
+	names       *resolvedNames
+	blocks      *blockHeaders
+	heads       event.Feed
+	costs       chan *PriceUpdate
+	aggregators *pendingTargets
+	sequencer   *sequencerStatus
+	events      chan Event
+}
+
+// New creates a Monitor for the given config. Blocks are only monitored if
+// backend also implements BlockBackend.
+func New(config Config, backend bind.ContractBackend) *Monitor {
+	if config.PollInterval <= 0 {
+		config.PollInterval = defaultPollInterval
+	}
+	if config.QueryTimeout <= 0 {
+		config.QueryTimeout = defaultQueryTimeout
+	}
+	if config.SequencerGracePeriod <= 0 {
+		config.SequencerGracePeriod = defaultSequencerGracePeriod
+	}
+	m := &Monitor{
+		config:      config,
+		backend:     backend,
+		gas:         NewGasOracle(config.ChainConfig),
+		latest:      newLatestPrices(),
+		names:       newResolvedNames(),
+		blocks:      newBlockHeaders(),
+		aggregators: newPendingTargets(),
+		events:      make(chan Event, eventsBufSize),
+	}
+	if _, ok := backend.(TransactionBackend); ok && config.UpdateCosts {
+		m.costs = make(chan *PriceUpdate, updateCostQueueSize)
+	}
+	if config.SequencerUptimeFeed != (common.Address{}) {
+		m.sequencer = newSequencerStatus(config.SequencerGracePeriod)
+	}
+	return m
+}
+
+// Events returns the stream of events. It is closed once Run returns, so it
+// has to be drained for Run to make progress.
+func (m *Monitor) Events() <-chan Event {
+	return m.events
+}
+
+// GasEstimate returns the latest gas price suggestions or nil if no block
+// has been observed yet.
+func (m *Monitor) GasEstimate() *GasEstimate {
+	return m.gas.Estimate()
+}
+
+// Run monitors everything listed in the config until ctx is cancelled or
+// all subscriptions fail to be established. Subscriptions failing later on
+// are re-established.
+func (m *Monitor) Run(ctx context.Context) {
+	defer close(m.events)
+
+	wg := sync.WaitGroup{}
+	wg.Add(len(m.config.Feeds) + len(m.config.Contracts) + len(m.config.DEXPools) + len(m.config.DivergenceGroups) + 1)
+
+	go m.subscribeBlocks(ctx, &wg)
+	for _, registry := range registries(m.config.Feeds) {
+		wg.Add(1)
+		go m.watchRegistryOwnership(ctx, registry, &wg)
+	}
+	if m.sequencer != nil {
+		wg.Add(1)
+		go m.watchSequencer(ctx, &wg)
+	}
+	if m.config.UpdateCosts {
+		wg.Add(1)
+		go m.watchUpdateCosts(ctx, &wg)
+	}
+	if m.config.Mempool {
+		wg.Add(1)
+		go m.watchMempool(ctx, &wg)
+	}
+	for _, feed := range m.config.Feeds {
+		if feed.Name != "" {
+			go m.followName(ctx, feed, &wg)
+		} else {
+			go m.monitorFeed(ctx, feed, &wg)
+		}
+	}
+	for _, contract := range m.config.Contracts {
+		go m.subscribeContractEvents(ctx, contract, &wg)
+	}
+	for _, pool := range m.config.DEXPools {
+		go m.watchDEXPool(ctx, pool, &wg)
+	}
+	for _, group := range m.config.DivergenceGroups {
+		go m.watchDivergence(ctx, group, &wg)
+	}
+
+	wg.Wait()
+}
+
+func (m *Monitor) monitorFeed(ctx context.Context, feed Feed, wg *sync.WaitGroup) {
+	defer wg.Done()
+
+	feedWg := sync.WaitGroup{}
+	feedWg.Add(2)
+	go m.subscribeEvents(ctx, feed, &feedWg)
+	go m.monitorGovernance(ctx, feed, &feedWg)
+	feedWg.Wait()
+}
+
+func (m *Monitor) emit(ctx context.Context, ev Event) {
+	select {
+	case m.events <- ev:
+	case <-ctx.Done():
+	}
+}
+
+// callOpts limits a contract call to the configured query timeout, cancel
+// has to be called once the call returns.
+func (m *Monitor) callOpts(ctx context.Context) (opts *bind.CallOpts, cancel context.CancelFunc) {
+	timeoutCtx, cancel := context.WithTimeout(ctx, m.config.QueryTimeout)
+	return &bind.CallOpts{Context: timeoutCtx}, cancel
+}
+
+// resubscribe keeps the established subscription sub alive. Whenever it
+// fails, dropped is called with the error and subscribe is retried with
+// backoff until it succeeds, every failed attempt is passed to failed. The
+// returned subscription only ends when it is unsubscribed.
+func resubscribe(sub ethereum.Subscription, subscribe func(context.Context) (ethereum.Subscription, error),
+	dropped, failed func(error)) event.Subscription {
+	established := sub
+	reconnecting := false
+	return event.ResubscribeErr(resubscribeBackoff, func(ctx context.Context, lastErr error) (event.Subscription, error) {
+		if established != nil {
+			first := established
+			established = nil
+			return first, nil
+		}
+		if !reconnecting {
+			dropped(lastErr)
+			reconnecting = true
+		}
+		newSub, err := subscribe(ctx)
+		if err != nil {
+			failed(err)
+			return nil, err
+		}
+		reconnecting = false
+		return newSub, nil
+	})
+}
+
+func (m *Monitor) feedError(ctx context.Context, feed Feed, msg string, err error) {
+	m.emit(ctx, &FeedError{Feed: feed, Message: msg, Err: err})
+}
+
+func (m *Monitor) subscribeBlocks(ctx context.Context, wg *sync.WaitGroup) {
+	defer wg.Done()
+
+	blockBackend, ok := m.backend.(BlockBackend)
+	if !ok {
+		m.emit(ctx, &BlockError{Message: "Failed subscribing to block creation", Err: errNoBlockBackend})
+		return
+	}
+
+	headers := make(chan *types.Header)
+	sub, err := blockBackend.SubscribeNewHead(ctx, headers)
+	if err != nil {
+		m.emit(ctx, &BlockError{Message: "Failed subscribing to block creation", Err: err})
+		return
+	}
+	resub := resubscribe(sub,
+		func(subCtx context.Context) (ethereum.Subscription, error) {
+			return blockBackend.SubscribeNewHead(subCtx, headers)
+		},
+		func(err error) {
+			m.emit(ctx, &BlockError{Message: "Failed while listening for new blocks", Err: err})
+		},
+		func(err error) {
+			m.emit(ctx, &BlockError{Message: "Failed resubscribing to block creation", Err: err})
+		})
+	defer resub.Unsubscribe()
+
+	m.emit(ctx, &Subscribed{Kind: SubscriptionBlocks})
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case header := <-headers:
+			m.heads.Send(header)
+			timeoutCtx, cancel := context.WithTimeout(ctx, m.config.QueryTimeout)
+			block, err := blockBackend.BlockByHash(timeoutCtx, header.Hash())
+			cancel()
+			if err != nil {
+				m.emit(ctx, &BlockError{Message: "Failed getting block by hash", Err: err})
+				continue
+			}
+			m.emit(ctx, &NewBlock{
+				Number:       block.NumberU64(),
+				Hash:         block.Hash(),
+				Time:         time.Unix(int64(block.Time()), 0),
+				BaseFee:      block.BaseFee(),
+				Transactions: len(block.Transactions()),
+			})
+			m.blocks.set(block.Hash(), block.Header())
+
+			m.gas.AddBlock(block)
+			if estimate := m.gas.Estimate(); estimate != nil {
+				m.emit(ctx, &GasUpdate{Estimate: estimate})
+			}
+		}
+	}
+}
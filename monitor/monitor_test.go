@@ -0,0 +1,419 @@
+package monitor_test
+
+import (
+	"context"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"hw-3/monitor"
+	"hw-3/simchain"
+	"hw-3/simchain/fluxaggregator"
+)
+
+const (
+	eventTimeout = 10 * time.Second
+	pollInterval = 50 * time.Millisecond
+)
+
+func newChain(t *testing.T) *simchain.Chain {
+	t.Helper()
+	chain, err := simchain.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { chain.Close() })
+	return chain
+}
+
+func deployFeed(t *testing.T, chain *simchain.Chain, answer int64) *simchain.Feed {
+	t.Helper()
+	feed, err := chain.DeployFeed(8, big.NewInt(answer))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return feed
+}
+
+// startMonitor runs a monitor and waits until all of its subscriptions are
+// established.
+func startMonitor(t *testing.T, backend bind.ContractBackend, config monitor.Config) <-chan monitor.Event {
+	t.Helper()
+	config.PollInterval = pollInterval
+	mon := monitor.New(config, backend)
+	ctx, cancel := context.WithCancel(context.Background())
+	go mon.Run(ctx)
+	t.Cleanup(func() {
+		cancel()
+		for range mon.Events() {
+		}
+	})
+
+	pending := 1 + 2*len(config.Feeds) + len(config.Contracts) + len(config.DEXPools)
+	registries := map[common.Address]bool{}
+	for _, feed := range config.Feeds {
+		if feed.Registry() {
+			registries[feed.Address] = true
+		}
+	}
+	pending += len(registries)
+	if config.Mempool {
+		pending++
+	}
+	for pending > 0 {
+		nextEvent[*monitor.Subscribed](t, mon.Events())
+		pending--
+	}
+	return mon.Events()
+}
+
+// nextEvent skips events until one of type T arrives.
+func nextEvent[T monitor.Event](t *testing.T, events <-chan monitor.Event) T {
+	t.Helper()
+	timeout := time.After(eventTimeout)
+	for {
+		select {
+		case ev, ok := <-events:
+			if !ok {
+				t.Fatal("events channel closed")
+			}
+			if errEvent, ok := ev.(error); ok {
+				if _, expected := ev.(T); !expected {
+					t.Fatalf("unexpected error event: %s", errEvent)
+				}
+			}
+			if typed, ok := ev.(T); ok {
+				return typed
+			}
+		case <-timeout:
+			var zero T
+			t.Fatalf("timed out waiting for %T", zero)
+		}
+	}
+}
+
+func TestPriceUpdates(t *testing.T) {
+	chain := newChain(t)
+	feed := deployFeed(t, chain, 100)
+	events := startMonitor(t, chain.Backend, monitor.Config{
+		Feeds: []monitor.Feed{{Tokens: "ETH / USD", Address: feed.Address}},
+	})
+
+	if err := chain.PushAnswer(feed.Aggregator, big.NewInt(123456789012)); err != nil {
+		t.Fatal(err)
+	}
+
+	started := nextEvent[*monitor.RoundStarted](t, events)
+	if started.StartedBy != chain.Oracles[0].From {
+		t.Errorf("expected round started by %s, got %s", chain.Oracles[0].From, started.StartedBy)
+	}
+	answered := nextEvent[*monitor.RoundAnswered](t, events)
+	if answered.RoundID != started.RoundID {
+		t.Errorf("expected round %d to be answered, got %d", started.RoundID, answered.RoundID)
+	}
+	price := nextEvent[*monitor.PriceUpdate](t, events)
+	if price.Feed.Tokens != "ETH / USD" {
+		t.Errorf("unexpected feed %s", price.Feed.Tokens)
+	}
+	if got := price.Price.String(); got != "1234.56789012" {
+		t.Errorf("expected price 1234.56789012, got %s", got)
+	}
+	if price.Aggregator != feed.Aggregator.Address {
+		t.Errorf("expected aggregator %s, got %s", feed.Aggregator.Address, price.Aggregator)
+	}
+}
+
+func TestUnansweredRound(t *testing.T) {
+	chain := newChain(t)
+	feed := deployFeed(t, chain, 100)
+	agg, err := chain.DeployAggregator(8, 2, 60)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := chain.ProposeAggregator(feed, agg); err != nil {
+		t.Fatal(err)
+	}
+	if err := chain.ConfirmAggregator(feed, agg); err != nil {
+		t.Fatal(err)
+	}
+	events := startMonitor(t, chain.Backend, monitor.Config{
+		Feeds: []monitor.Feed{{Tokens: "ETH / USD", Address: feed.Address}},
+	})
+
+	// A single submission starts the round, but two are needed to answer it
+	if err := chain.Submit(agg, 0, 1, big.NewInt(100)); err != nil {
+		t.Fatal(err)
+	}
+	nextEvent[*monitor.RoundStarted](t, events)
+
+	if err := chain.AdjustTime(2 * time.Minute); err != nil {
+		t.Fatal(err)
+	}
+	if err := chain.Submit(agg, 0, 2, big.NewInt(200)); err != nil {
+		t.Fatal(err)
+	}
+	if err := chain.Submit(agg, 1, 2, big.NewInt(200)); err != nil {
+		t.Fatal(err)
+	}
+
+	unanswered := nextEvent[*monitor.RoundUnanswered](t, events)
+	if unanswered.RoundID != 1 || unanswered.Reason != "superseded" {
+		t.Errorf("expected round 1 to be superseded, got round %d %s", unanswered.RoundID, unanswered.Reason)
+	}
+}
+
+func TestBlocks(t *testing.T) {
+	chain := newChain(t)
+	events := startMonitor(t, chain.Backend, monitor.Config{})
+
+	hash := chain.Commit()
+	block := nextEvent[*monitor.NewBlock](t, events)
+	if block.Hash != hash {
+		t.Errorf("expected block %s, got %s", hash, block.Hash)
+	}
+	gas := nextEvent[*monitor.GasUpdate](t, events)
+	if gas.Estimate.Block != block.Number {
+		t.Errorf("expected gas estimate for block %d, got %d", block.Number, gas.Estimate.Block)
+	}
+	if gas.Estimate.NextBaseFee.Sign() <= 0 {
+		t.Errorf("expected positive next base fee, got %s", gas.Estimate.NextBaseFee)
+	}
+}
+
+func TestGovernance(t *testing.T) {
+	chain := newChain(t)
+	feed := deployFeed(t, chain, 100_00000000)
+	events := startMonitor(t, chain.Backend, monitor.Config{
+		Feeds: []monitor.Feed{{Tokens: "ETH / USD", Address: feed.Address}},
+	})
+
+	newOwner := chain.Accounts[0]
+	if err := chain.TransferOwnership(feed, chain.Owner, newOwner); err != nil {
+		t.Fatal(err)
+	}
+	alert := nextEvent[*monitor.GovernanceAlert](t, events)
+	if alert.Kind != monitor.OwnershipTransferRequested || alert.To != newOwner.From {
+		t.Errorf("unexpected alert %s to %s", alert.Kind, alert.To)
+	}
+	if err := chain.AcceptOwnership(feed, newOwner); err != nil {
+		t.Fatal(err)
+	}
+	alert = nextEvent[*monitor.GovernanceAlert](t, events)
+	if alert.Kind != monitor.OwnershipTransferred || alert.From != chain.Owner.From {
+		t.Errorf("unexpected alert %s from %s", alert.Kind, alert.From)
+	}
+
+	chain.Owner = newOwner
+	next, err := chain.DeployAggregator(8, 1, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := chain.PushAnswer(next, big.NewInt(110_00000000)); err != nil {
+		t.Fatal(err)
+	}
+	if err := chain.ProposeAggregator(feed, next); err != nil {
+		t.Fatal(err)
+	}
+	alert = nextEvent[*monitor.GovernanceAlert](t, events)
+	if alert.Kind != monitor.AggregatorProposed || alert.To != next.Address {
+		t.Errorf("unexpected alert %s to %s", alert.Kind, alert.To)
+	}
+	deviation := nextEvent[*monitor.ProposedDeviation](t, events)
+	if deviation.DeviationPercent != 10 {
+		t.Errorf("expected 10%% deviation, got %f", deviation.DeviationPercent)
+	}
+
+	if err := chain.ConfirmAggregator(feed, next); err != nil {
+		t.Fatal(err)
+	}
+	alert = nextEvent[*monitor.GovernanceAlert](t, events)
+	if alert.Kind != monitor.AggregatorConfirmed || alert.To != next.Address {
+		t.Errorf("unexpected alert %s to %s", alert.Kind, alert.To)
+	}
+}
+
+func TestAggregatorRotation(t *testing.T) {
+	chain := newChain(t)
+	feed := deployFeed(t, chain, 100_00000000)
+	events := startMonitor(t, chain.Backend, monitor.Config{
+		Feeds: []monitor.Feed{{Tokens: "ETH / USD", Address: feed.Address}},
+	})
+
+	next, err := chain.DeployAggregator(8, 1, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := chain.PushAnswer(next, big.NewInt(105_00000000)); err != nil {
+		t.Fatal(err)
+	}
+	if err := chain.ProposeAggregator(feed, next); err != nil {
+		t.Fatal(err)
+	}
+	nextEvent[*monitor.ProposedDeviation](t, events)
+	if err := chain.ConfirmAggregator(feed, next); err != nil {
+		t.Fatal(err)
+	}
+	for {
+		subscribed := nextEvent[*monitor.Subscribed](t, events)
+		if subscribed.Kind == monitor.SubscriptionPrice && subscribed.Aggregator == next.Address {
+			break
+		}
+	}
+
+	if err := chain.PushAnswer(next, big.NewInt(110_00000000)); err != nil {
+		t.Fatal(err)
+	}
+	price := nextEvent[*monitor.PriceUpdate](t, events)
+	if price.Aggregator != next.Address {
+		t.Errorf("expected price update of aggregator %s, got %s", next.Address, price.Aggregator)
+	}
+	if got := price.Price.String(); got != "110.00000000" {
+		t.Errorf("expected price 110.00000000, got %s", got)
+	}
+}
+
+func TestContractEvents(t *testing.T) {
+	chain := newChain(t)
+	agg, err := chain.DeployAggregator(8, 1, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	aggABI, err := abi.JSON(strings.NewReader(fluxaggregator.FluxAggregatorMetaData.ABI))
+	if err != nil {
+		t.Fatal(err)
+	}
+	events := startMonitor(t, chain.Backend, monitor.Config{
+		Contracts: []monitor.Contract{{
+			Name:    "flux",
+			Address: agg.Address,
+			ABI:     aggABI,
+			Events:  []string{"SubmissionReceived"},
+		}},
+	})
+
+	if err := chain.PushAnswer(agg, big.NewInt(42)); err != nil {
+		t.Fatal(err)
+	}
+	ev := nextEvent[*monitor.ContractEvent](t, events)
+	if ev.Event != "SubmissionReceived" {
+		t.Errorf("unexpected event %s", ev.Event)
+	}
+	if ev.Args["submission"] != "42" || ev.Args["round"] != "1" {
+		t.Errorf("unexpected args %v", ev.Args)
+	}
+	if ev.Args["oracle"] != chain.Oracles[0].From.Hex() {
+		t.Errorf("expected oracle %s, got %s", chain.Oracles[0].From.Hex(), ev.Args["oracle"])
+	}
+}
+
+func TestContractAllEvents(t *testing.T) {
+	chain := newChain(t)
+	agg, err := chain.DeployAggregator(8, 1, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	aggABI, err := abi.JSON(strings.NewReader(fluxaggregator.FluxAggregatorMetaData.ABI))
+	if err != nil {
+		t.Fatal(err)
+	}
+	events := startMonitor(t, chain.Backend, monitor.Config{
+		Contracts: []monitor.Contract{{Name: "flux", Address: agg.Address, ABI: aggABI}},
+	})
+
+	if err := chain.PushAnswer(agg, big.NewInt(42)); err != nil {
+		t.Fatal(err)
+	}
+	seen := map[string]bool{}
+	for !seen["SubmissionReceived"] || !seen["AnswerUpdated"] {
+		seen[nextEvent[*monitor.ContractEvent](t, events).Event] = true
+	}
+}
+
+func TestQuerySnapshot(t *testing.T) {
+	chain := newChain(t)
+	feed := deployFeed(t, chain, 123456789012)
+
+	snapshot, err := monitor.QuerySnapshot(context.Background(), chain.Backend,
+		monitor.Feed{Tokens: "ETH / USD", Address: feed.Address})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := snapshot.Price.String(); got != "1234.56789012" {
+		t.Errorf("expected price 1234.56789012, got %s", got)
+	}
+	if snapshot.PhaseID != 1 {
+		t.Errorf("expected phase 1, got %d", snapshot.PhaseID)
+	}
+	// Proxy round ids carry the phase in the bits above the aggregator round id
+	if expected := new(big.Int).Add(new(big.Int).Lsh(big.NewInt(1), 64), big.NewInt(1)); snapshot.RoundID.Cmp(expected) != 0 {
+		t.Errorf("expected round %s, got %s", expected, snapshot.RoundID)
+	}
+	if snapshot.Version.Sign() <= 0 || snapshot.UpdatedAt.IsZero() {
+		t.Errorf("expected version and update time, got version %s updated at %s", snapshot.Version, snapshot.UpdatedAt)
+	}
+}
+
+func TestInspect(t *testing.T) {
+	chain := newChain(t)
+	feed := deployFeed(t, chain, 100)
+	first := feed.Aggregator
+	for _, answer := range []int64{101, 102} {
+		if err := chain.AdjustTime(time.Hour); err != nil {
+			t.Fatal(err)
+		}
+		if err := chain.PushAnswer(first, big.NewInt(answer)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	second, err := chain.DeployAggregator(8, 1, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := chain.ProposeAggregator(feed, second); err != nil {
+		t.Fatal(err)
+	}
+	if err := chain.ConfirmAggregator(feed, second); err != nil {
+		t.Fatal(err)
+	}
+	for _, answer := range []int64{200, 201, 202} {
+		if err := chain.AdjustTime(30 * time.Minute); err != nil {
+			t.Fatal(err)
+		}
+		if err := chain.PushAnswer(second, big.NewInt(answer)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	newOwner := chain.Accounts[0]
+	if err := chain.TransferOwnership(feed, chain.Owner, newOwner); err != nil {
+		t.Fatal(err)
+	}
+	if err := chain.AcceptOwnership(feed, newOwner); err != nil {
+		t.Fatal(err)
+	}
+
+	inspection, err := monitor.Inspect(context.Background(), chain.Backend, feed.Address, monitor.InspectOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if inspection.Owner != newOwner.From || inspection.Aggregator != second.Address {
+		t.Errorf("expected owner %s and aggregator %s, got %s and %s",
+			newOwner.From, second.Address, inspection.Owner, inspection.Aggregator)
+	}
+	if len(inspection.Phases) != 2 || inspection.Phases[0].Aggregator != first.Address {
+		t.Fatalf("expected 2 phases starting with %s, got %+v", first.Address, inspection.Phases)
+	}
+	if last := inspection.Phases[0].LastRound; last == nil || last.Int64() != 3 {
+		t.Errorf("expected 3 rounds in the first phase, got %v", last)
+	}
+	transfers := inspection.Transfers
+	if len(transfers) == 0 || transfers[len(transfers)-1].To != newOwner.From {
+		t.Errorf("expected ownership transfer to %s, got %+v", newOwner.From, transfers)
+	}
+	if cadence := inspection.Cadence; cadence == nil || cadence.Rounds != 3 || cadence.Median < 30*time.Minute {
+		t.Errorf("expected 3 rounds at least 30 minutes apart, got %+v", cadence)
+	}
+}
@@ -0,0 +1,160 @@
+package monitor_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"hw-3/aggregator"
+	"hw-3/fakenode"
+	"hw-3/monitor"
+	"hw-3/proxy"
+	"hw-3/registry"
+)
+
+var (
+	registryAddress = common.HexToAddress("0x47Fb2585D2C56Fe188D0E6ec628a38b74fCeeeDf")
+	linkAddress     = common.HexToAddress("0x514910771AF9Ca656af840dff83E8264EcF986CA")
+	usdAddress      = common.BigToAddress(big.NewInt(840))
+)
+
+// registryNode starts a fake node whose Feed Registry resolves LINK / USD to
+// the aggregator at aggregatorAddress, which has 8 decimals.
+func registryNode(t *testing.T) *fakenode.Node {
+	t.Helper()
+	node, err := fakenode.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(node.Close)
+
+	registryABI := parseABI(t, registry.RegistryMetaData.ABI)
+	aggABI := parseABI(t, aggregator.AggregatorMetaData.ABI)
+	// Round 7 of phase 1
+	roundID := new(big.Int).Or(new(big.Int).Lsh(big.NewInt(1), 64), big.NewInt(7))
+	for _, err := range []error{
+		node.SetCallResult(registryAddress, registryABI, "getFeed", aggregatorAddress),
+		node.SetCallResult(registryAddress, registryABI, "getProposedFeed", common.Address{}),
+		node.SetCallResult(registryAddress, registryABI, "decimals", uint8(8)),
+		node.SetCallResult(registryAddress, registryABI, "latestRoundData",
+			roundID, big.NewInt(7_12345678), big.NewInt(1000), big.NewInt(1010), roundID),
+		node.SetCallResult(registryAddress, registryABI, "description", "LINK / USD"),
+		node.SetCallResult(registryAddress, registryABI, "version", big.NewInt(4)),
+		node.SetCallResult(registryAddress, registryABI, "getCurrentPhaseId", uint16(1)),
+		node.SetCallResult(aggregatorAddress, aggABI, "decimals", uint8(8)),
+	} {
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	return node
+}
+
+func linkUSD() monitor.Feed {
+	return monitor.Feed{Tokens: "LINK / USD", Address: registryAddress, Base: linkAddress, Quote: usdAddress}
+}
+
+func TestRegistryFeed(t *testing.T) {
+	node := registryNode(t)
+	events := startMonitor(t, dialNode(t, node), monitor.Config{Feeds: []monitor.Feed{linkUSD()}})
+
+	block := node.Mine()
+	node.PushLogs(answerUpdated(t, block, 7_12345678, 7))
+	price := nextEvent[*monitor.PriceUpdate](t, events)
+	if price.Feed != linkUSD() || price.Aggregator != aggregatorAddress {
+		t.Errorf("unexpected price update of %s from %s", price.Feed.Tokens, price.Aggregator)
+	}
+	if got := price.Price.String(); got != "7.12345678" {
+		t.Errorf("expected price 7.12345678, got %s", got)
+	}
+
+	// The registry is switched to a new aggregator
+	next := common.HexToAddress("0x2c1d072e956AFFC0D435Cb7AC38EF18d24d9127c")
+	registryABI := parseABI(t, registry.RegistryMetaData.ABI)
+	aggABI := parseABI(t, aggregator.AggregatorMetaData.ABI)
+	if err := node.SetCallResult(next, aggABI, "decimals", uint8(8)); err != nil {
+		t.Fatal(err)
+	}
+	if err := node.SetCallResult(registryAddress, registryABI, "getFeed", next); err != nil {
+		t.Fatal(err)
+	}
+	var confirmed *monitor.GovernanceAlert
+	var resubscribed *monitor.Subscribed
+	for confirmed == nil || resubscribed == nil {
+		switch ev := skipTo[monitor.Event](t, events).(type) {
+		case *monitor.GovernanceAlert:
+			confirmed = ev
+		case *monitor.Subscribed:
+			resubscribed = ev
+		}
+	}
+	if confirmed.Kind != monitor.AggregatorConfirmed || confirmed.From != aggregatorAddress || confirmed.To != next {
+		t.Errorf("unexpected alert %s from %s to %s", confirmed.Kind, confirmed.From, confirmed.To)
+	}
+	if resubscribed.Kind != monitor.SubscriptionPrice || resubscribed.Aggregator != next {
+		t.Errorf("expected price subscription to %s, got %s to %s", next, resubscribed.Kind, resubscribed.Aggregator)
+	}
+
+	update := answerUpdated(t, node.Mine(), 7_50000000, 1)
+	update.Address = next
+	node.PushLogs(update)
+	if price := nextEvent[*monitor.PriceUpdate](t, events); price.Aggregator != next {
+		t.Errorf("expected price update from %s, got %s", next, price.Aggregator)
+	}
+}
+
+func TestRegistrySnapshot(t *testing.T) {
+	node := registryNode(t)
+	snapshot, err := monitor.QuerySnapshot(context.Background(), dialNode(t, node), linkUSD())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if snapshot.Description != "LINK / USD" || snapshot.PhaseID != 1 || snapshot.Version.Int64() != 4 {
+		t.Errorf("unexpected metadata %q, phase %d, version %s", snapshot.Description, snapshot.PhaseID, snapshot.Version)
+	}
+	if got := snapshot.Price.String(); got != "7.12345678" {
+		t.Errorf("expected price 7.12345678, got %s", got)
+	}
+}
+
+func TestRegistryOwnership(t *testing.T) {
+	node := registryNode(t)
+	ethUSD := monitor.Feed{Tokens: "ETH / USD", Address: registryAddress, Base: common.HexToAddress("0xEeeeeEeeeEeEeeEeEeEeeEEEeeeeEeeeeeeeEEeE"), Quote: usdAddress}
+	events := startMonitor(t, dialNode(t, node), monitor.Config{Feeds: []monitor.Feed{linkUSD(), ethUSD}})
+
+	// Both feeds share the registry, its ownership change is reported once
+	block := node.Mine()
+	owner, newOwner := common.Address{1}, common.Address{2}
+	node.PushLogs(types.Log{
+		Address: registryAddress,
+		Topics: []common.Hash{
+			parseABI(t, proxy.ProxyMetaData.ABI).Events["OwnershipTransferred"].ID,
+			common.BytesToHash(owner.Bytes()),
+			common.BytesToHash(newOwner.Bytes()),
+		},
+		BlockNumber: block.Number.Uint64(),
+		BlockHash:   block.Hash(),
+	})
+	alert := nextEvent[*monitor.GovernanceAlert](t, events)
+	if alert.Kind != monitor.OwnershipTransferred || alert.Feed.Address != registryAddress || alert.To != newOwner {
+		t.Errorf("unexpected alert %s of %s to %s", alert.Kind, alert.Feed.Address, alert.To)
+	}
+	// The registry's second alert would arrive right after the first
+	node.Mine()
+	for {
+		select {
+		case ev := <-events:
+			if _, ok := ev.(*monitor.NewBlock); ok {
+				return
+			}
+			if alert, ok := ev.(*monitor.GovernanceAlert); ok {
+				t.Fatalf("unexpected second alert %s of %s", alert.Kind, alert.Feed.Tokens)
+			}
+		case <-time.After(eventTimeout):
+			t.Fatal("timed out waiting for block")
+		}
+	}
+}
@@ -0,0 +1,107 @@
+package monitor
+
+import (
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"hw-3/aggregator"
+)
+
+const (
+	roundAnswerTimeout = time.Hour
+)
+
+type startedRound struct {
+	startedBy common.Address
+	startedAt time.Time
+}
+
+// roundTracker correlates NewRound and AnswerUpdated events of a single feed
+// to find out how long rounds take and which of them are never answered.
+// chainTime is the latest timestamp of the events, rounds time out in chain
+// time so that replaying an old capture doesn't expire all of them.
+type roundTracker struct {
+	feed      Feed
+	rounds    map[uint64]startedRound
+	chainTime time.Time
+}
+
+func newRoundTracker(feed Feed) *roundTracker {
+	return &roundTracker{
+		feed:   feed,
+		rounds: make(map[uint64]startedRound),
+	}
+}
+
+func (t *roundTracker) started(ev *aggregator.AggregatorNewRound) Event {
+	roundID := ev.RoundId.Uint64()
+	round := startedRound{
+		startedBy: ev.StartedBy,
+		startedAt: time.Unix(ev.StartedAt.Int64(), 0),
+	}
+	t.rounds[roundID] = round
+	t.observed(round.startedAt)
+	return &RoundStarted{
+		Feed:      t.feed,
+		RoundID:   roundID,
+		StartedBy: round.startedBy,
+		StartedAt: round.startedAt,
+	}
+}
+
+func (t *roundTracker) answered(ans *aggregator.AggregatorAnswerUpdated) []Event {
+	var events []Event
+
+	roundID := ans.RoundId.Uint64()
+	updatedAt := time.Unix(ans.UpdatedAt.Int64(), 0)
+	t.observed(updatedAt)
+	round, ok := t.rounds[roundID]
+	if ok {
+		delete(t.rounds, roundID)
+		events = append(events, &RoundAnswered{
+			Feed:      t.feed,
+			RoundID:   roundID,
+			StartedBy: round.startedBy,
+			Duration:  updatedAt.Sub(round.startedAt),
+		})
+	}
+
+	// Rounds are answered in order, so earlier rounds still waiting for an answer never get one
+	for id, round := range t.rounds {
+		if id < roundID {
+			events = append(events, t.unanswered(id, round, "superseded"))
+		}
+	}
+	return events
+}
+
+func (t *roundTracker) observed(chainTime time.Time) {
+	if chainTime.After(t.chainTime) {
+		t.chainTime = chainTime
+	}
+}
+
+// expire reports the rounds started more than roundAnswerTimeout before the
+// latest chain time, which blockTime advances if the chain moved on without
+// events of the feed.
+func (t *roundTracker) expire(blockTime time.Time) []Event {
+	t.observed(blockTime)
+	var events []Event
+	for id, round := range t.rounds {
+		if t.chainTime.Sub(round.startedAt) > roundAnswerTimeout {
+			events = append(events, t.unanswered(id, round, "timeout"))
+		}
+	}
+	return events
+}
+
+func (t *roundTracker) unanswered(roundID uint64, round startedRound, reason string) Event {
+	delete(t.rounds, roundID)
+	return &RoundUnanswered{
+		Feed:      t.feed,
+		RoundID:   roundID,
+		StartedBy: round.startedBy,
+		StartedAt: round.startedAt,
+		Reason:    reason,
+	}
+}
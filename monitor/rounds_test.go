@@ -0,0 +1,35 @@
+package monitor
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"hw-3/aggregator"
+)
+
+func TestRoundTimeoutInChainTime(t *testing.T) {
+	tracker := newRoundTracker(Feed{Tokens: "ETH / USD"})
+	newRound := func(roundID int64, startedAt time.Time) {
+		tracker.started(&aggregator.AggregatorNewRound{RoundId: big.NewInt(roundID), StartedAt: big.NewInt(startedAt.Unix())})
+	}
+	// Rounds of a capture recorded long ago
+	startedAt := time.Date(2022, 11, 1, 12, 0, 0, 0, time.UTC)
+	newRound(1, startedAt)
+	if events := tracker.expire(startedAt.Add(roundAnswerTimeout / 2)); len(events) != 0 {
+		t.Errorf("expected the round not to time out within the timeout in chain time, got %d events", len(events))
+	}
+
+	// The chain moved on, but no newer block was observed
+	newRound(2, startedAt.Add(2*roundAnswerTimeout))
+	events := tracker.expire(time.Time{})
+	if len(events) != 1 {
+		t.Fatalf("expected one round to time out, got %d events", len(events))
+	}
+	if unanswered, ok := events[0].(*RoundUnanswered); !ok || unanswered.RoundID != 1 || unanswered.Reason != "timeout" {
+		t.Errorf("expected round 1 to time out, got %+v", events[0])
+	}
+	if events := tracker.expire(startedAt.Add(5 * roundAnswerTimeout / 2)); len(events) != 0 {
+		t.Errorf("expected round 2 not to time out yet, got %d events", len(events))
+	}
+}
@@ -0,0 +1,128 @@
+package monitor
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"hw-3/aggregator"
+)
+
+const (
+	defaultSequencerGracePeriod = time.Hour
+	sequencerFeedName           = "Sequencer uptime"
+)
+
+// sequencerStatus tracks the status reported by the sequencer uptime feed of
+// an L2 network, an answer of 0 means the sequencer is up and 1 that it is
+// down.
+type sequencerStatus struct {
+	mu          sync.RWMutex
+	known       bool
+	up          bool
+	since       time.Time
+	gracePeriod time.Duration
+}
+
+func newSequencerStatus(gracePeriod time.Duration) *sequencerStatus {
+	return &sequencerStatus{gracePeriod: gracePeriod}
+}
+
+// set records the status and reports whether it changed.
+func (s *sequencerStatus) set(up bool, since time.Time) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	changed := !s.known || s.up != up || !s.since.Equal(since)
+	s.known, s.up, s.since = true, up, since
+	return changed
+}
+
+// unreliable reports whether a price updated at the given time can't be
+// trusted: the sequencer status is not known yet, the sequencer is down or
+// the update falls into the grace period after it came back up. Without an
+// uptime feed every price is reliable.
+func (s *sequencerStatus) unreliable(updatedAt time.Time) bool {
+	if s == nil {
+		return false
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return !s.known || !s.up || updatedAt.Before(s.since.Add(s.gracePeriod))
+}
+
+// querySequencer returns whether the sequencer is up and since when.
+func querySequencer(opts *bind.CallOpts, uptimeFeed *aggregator.AggregatorCaller) (up bool, since time.Time, err error) {
+	data, err := uptimeFeed.LatestRoundData(opts)
+	if err != nil {
+		return false, time.Time{}, err
+	}
+	return data.Answer.Sign() == 0, time.Unix(data.StartedAt.Int64(), 0), nil
+}
+
+// networkUnreliable reports whether prices of network can't be trusted at
+// now. The sequencer of another network is queried on every call, the
+// monitored network's one is polled by watchSequencer.
+func (m *Monitor) networkUnreliable(ctx context.Context, network string, now time.Time) (bool, error) {
+	if network == "" {
+		return m.sequencer.unreliable(now), nil
+	}
+	uptimeFeedAddress := m.config.Networks[network].SequencerUptimeFeed
+	if uptimeFeedAddress == (common.Address{}) {
+		return false, nil
+	}
+	uptimeFeed, err := aggregator.NewAggregatorCaller(uptimeFeedAddress, m.config.Networks[network].Backend)
+	if err != nil {
+		return true, err
+	}
+	opts, cancel := m.callOpts(ctx)
+	defer cancel()
+	up, since, err := querySequencer(opts, uptimeFeed)
+	if err != nil {
+		return true, err
+	}
+	status := newSequencerStatus(m.config.SequencerGracePeriod)
+	status.set(up, since)
+	return status.unreliable(now), nil
+}
+
+// watchSequencer polls the sequencer uptime feed and emits its status
+// whenever it changes.
+func (m *Monitor) watchSequencer(ctx context.Context, wg *sync.WaitGroup) {
+	defer wg.Done()
+
+	feed := Feed{Tokens: sequencerFeedName, Address: m.config.SequencerUptimeFeed}
+	uptimeFeed, err := aggregator.NewAggregatorCaller(feed.Address, m.backend)
+	if err != nil {
+		m.feedError(ctx, feed, "Failed acquiring sequencer uptime feed instance", err)
+		return
+	}
+
+	ticker := time.NewTicker(m.config.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		opts, cancel := m.callOpts(ctx)
+		up, since, err := querySequencer(opts, uptimeFeed)
+		cancel()
+		if err != nil {
+			m.feedError(ctx, feed, "Failed acquiring sequencer status", err)
+		} else {
+			if m.sequencer.set(up, since) {
+				m.emit(ctx, &SequencerStatus{
+					Feed:           feed.Address,
+					Up:             up,
+					Since:          since,
+					GracePeriodEnd: since.Add(m.sequencer.gracePeriod),
+				})
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
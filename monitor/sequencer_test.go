@@ -0,0 +1,116 @@
+package monitor_test
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"hw-3/aggregator"
+	"hw-3/fakenode"
+	"hw-3/monitor"
+	"hw-3/simchain"
+)
+
+var uptimeAddress = common.HexToAddress("0xFdB631F5EE196F0ed6FAa767959853A9F217697D")
+
+// setSequencer makes the sequencer uptime feed of node report the sequencer
+// up or down since the given time.
+func setSequencer(t *testing.T, node *fakenode.Node, up bool, since time.Time) {
+	t.Helper()
+	answer := big.NewInt(1)
+	if up {
+		answer = big.NewInt(0)
+	}
+	err := node.SetCallResult(uptimeAddress, parseABI(t, aggregator.AggregatorMetaData.ABI), "latestRoundData",
+		big.NewInt(1), answer, big.NewInt(since.Unix()), big.NewInt(since.Unix()), big.NewInt(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func nextStatus(t *testing.T, events <-chan monitor.Event, up bool) *monitor.SequencerStatus {
+	t.Helper()
+	for {
+		status := nextEvent[*monitor.SequencerStatus](t, events)
+		if status.Up == up {
+			return status
+		}
+	}
+}
+
+func pushPrice(t *testing.T, chain *simchain.Chain, feed *simchain.Feed, events <-chan monitor.Event, answer int64) *monitor.PriceUpdate {
+	t.Helper()
+	if err := chain.PushAnswer(feed.Aggregator, big.NewInt(answer)); err != nil {
+		t.Fatal(err)
+	}
+	return nextEvent[*monitor.PriceUpdate](t, events)
+}
+
+func TestSequencerUptime(t *testing.T) {
+	chain := newChain(t)
+	feed := deployFeed(t, chain, 100_00000000)
+	// The sequencer starts out down
+	uptime, err := chain.DeployFeed(0, big.NewInt(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	events := startMonitor(t, chain.Backend, monitor.Config{
+		Feeds:                []monitor.Feed{{Tokens: "ETH / USD", Address: feed.Address}},
+		SequencerUptimeFeed:  uptime.Address,
+		SequencerGracePeriod: time.Hour,
+	})
+
+	if err := chain.PushAnswer(uptime.Aggregator, big.NewInt(0)); err != nil {
+		t.Fatal(err)
+	}
+	status := nextStatus(t, events, true)
+	if expected := status.Since.Add(time.Hour); !status.GracePeriodEnd.Equal(expected) {
+		t.Errorf("expected grace period to end at %s, got %s", expected, status.GracePeriodEnd)
+	}
+	if price := pushPrice(t, chain, feed, events, 101_00000000); !price.Unreliable {
+		t.Error("expected price in the grace period to be unreliable")
+	}
+
+	if err := chain.AdjustTime(2 * time.Hour); err != nil {
+		t.Fatal(err)
+	}
+	if price := pushPrice(t, chain, feed, events, 102_00000000); price.Unreliable {
+		t.Error("expected price after the grace period to be reliable")
+	}
+
+	if err := chain.PushAnswer(uptime.Aggregator, big.NewInt(1)); err != nil {
+		t.Fatal(err)
+	}
+	nextStatus(t, events, false)
+	if price := pushPrice(t, chain, feed, events, 103_00000000); !price.Unreliable {
+		t.Error("expected price while the sequencer is down to be unreliable")
+	}
+}
+
+func TestSequencerReorgedPrice(t *testing.T) {
+	node := feedNode(t)
+	// The sequencer starts out down
+	setSequencer(t, node, false, time.Now().Add(-3*time.Hour))
+	events := startMonitor(t, dialNode(t, node), monitor.Config{
+		Feeds:                []monitor.Feed{{Tokens: "ETH / USD", Address: feedAddress}},
+		SequencerUptimeFeed:  uptimeAddress,
+		SequencerGracePeriod: time.Hour,
+	})
+	// It came back up two hours ago
+	since := time.Now().Add(-2 * time.Hour)
+	setSequencer(t, node, true, since)
+	nextStatus(t, events, true)
+
+	// The round was updated in the grace period, which is over by the time the reorg is observed
+	reverted := answerUpdated(t, node.Mine(), 1234_00000000, 1)
+	reverted.Data = common.BigToHash(big.NewInt(since.Unix() + 600)).Bytes()
+	node.PushLogs(reverted)
+	if price := nextEvent[*monitor.PriceUpdate](t, events); !price.Unreliable {
+		t.Error("expected price in the grace period to be unreliable")
+	}
+	node.Reorg(reverted)
+	if price := nextEvent[*monitor.PriceReverted](t, events); !price.Unreliable {
+		t.Error("expected reverted price in the grace period to be unreliable")
+	}
+}
@@ -0,0 +1,79 @@
+package monitor
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"hw-3/aggregator"
+	"hw-3/decimal"
+)
+
+// deviationScale is the number of fractional digits of the relative
+// deviation of the proposed answer.
+const deviationScale = 10
+
+var errZeroAnswer = errors.New("current answer is zero")
+
+func (m *Monitor) aggregatorDecimals(opts *bind.CallOpts, address common.Address) (uint8, error) {
+	aggregatorInstance, err := aggregator.NewAggregator(address, m.backend)
+	if err != nil {
+		return 0, err
+	}
+	return aggregatorInstance.Decimals(opts)
+}
+
+// compareProposedAggregator reports how far the answer of the proposed
+// aggregator is from the answer of the aggregator currently in use.
+func (m *Monitor) compareProposedAggregator(ctx context.Context, source feedSource, feed Feed, state aggregatorState) {
+	opts, cancel := m.callOpts(ctx)
+	defer cancel()
+
+	current, err := source.latestRoundData(opts)
+	if err != nil {
+		m.feedError(ctx, feed, "Failed acquiring current round data", err)
+		return
+	}
+	proposed, err := source.proposedLatestRoundData(opts)
+	if err != nil {
+		m.feedError(ctx, feed, "Failed acquiring proposed round data", err)
+		return
+	}
+	currentDecimals, err := m.aggregatorDecimals(opts, state.current)
+	if err != nil {
+		m.feedError(ctx, feed, "Failed acquiring current decimals", err)
+		return
+	}
+	proposedDecimals, err := m.aggregatorDecimals(opts, state.proposed)
+	if err != nil {
+		m.feedError(ctx, feed, "Failed acquiring proposed decimals", err)
+		return
+	}
+
+	currentPrice := decimal.New(current.Answer, currentDecimals)
+	proposedPrice := decimal.New(proposed.Answer, proposedDecimals)
+	if currentPrice.Sign() == 0 {
+		m.feedError(ctx, feed, "Failed comparing proposed aggregator", errZeroAnswer)
+		return
+	}
+	deviation, err := proposedPrice.Sub(currentPrice).Quo(currentPrice, deviationScale)
+	if err != nil {
+		m.feedError(ctx, feed, "Failed comparing proposed aggregator", err)
+		return
+	}
+
+	m.emit(ctx, &ProposedDeviation{
+		Feed:              feed,
+		Proposed:          state.proposed,
+		CurrentRoundID:    current.RoundId,
+		CurrentDecimals:   currentDecimals,
+		CurrentPrice:      currentPrice,
+		ProposedRoundID:   proposed.RoundId,
+		ProposedDecimals:  proposedDecimals,
+		ProposedPrice:     proposedPrice,
+		ProposedUpdatedAt: time.Unix(proposed.UpdatedAt.Int64(), 0),
+		DeviationPercent:  deviation.Float64() * 100,
+	})
+}
@@ -0,0 +1,132 @@
+package monitor
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"hw-3/proxy"
+	"hw-3/registry"
+)
+
+// roundData is the result of the round data calls of both proxies and the
+// Feed Registry.
+type roundData struct {
+	RoundId         *big.Int
+	Answer          *big.Int
+	StartedAt       *big.Int
+	UpdatedAt       *big.Int
+	AnsweredInRound *big.Int
+}
+
+// feedSource queries a feed either from its proxy or from the Feed Registry,
+// which offers the same calls for every base and quote pair.
+type feedSource interface {
+	aggregator(opts *bind.CallOpts) (common.Address, error)
+	proposedAggregator(opts *bind.CallOpts) (common.Address, error)
+	decimals(opts *bind.CallOpts) (uint8, error)
+	description(opts *bind.CallOpts) (string, error)
+	version(opts *bind.CallOpts) (*big.Int, error)
+	phaseID(opts *bind.CallOpts) (uint16, error)
+	latestRoundData(opts *bind.CallOpts) (roundData, error)
+	roundData(opts *bind.CallOpts, roundID *big.Int) (roundData, error)
+	proposedLatestRoundData(opts *bind.CallOpts) (roundData, error)
+}
+
+func newFeedSource(feed Feed, backend bind.ContractCaller) (feedSource, error) {
+	if feed.Registry() {
+		registryCaller, err := registry.NewRegistryCaller(feed.Address, backend)
+		if err != nil {
+			return nil, fmt.Errorf("failed acquiring registry instance: %w", err)
+		}
+		return &registrySource{caller: registryCaller, base: feed.Base, quote: feed.Quote}, nil
+	}
+	proxyCaller, err := proxy.NewProxyCaller(feed.Address, backend)
+	if err != nil {
+		return nil, fmt.Errorf("failed acquiring proxy instance: %w", err)
+	}
+	return &proxySource{caller: proxyCaller}, nil
+}
+
+type proxySource struct {
+	caller *proxy.ProxyCaller
+}
+
+func (s *proxySource) aggregator(opts *bind.CallOpts) (common.Address, error) {
+	return s.caller.Aggregator(opts)
+}
+
+func (s *proxySource) proposedAggregator(opts *bind.CallOpts) (common.Address, error) {
+	return s.caller.ProposedAggregator(opts)
+}
+
+func (s *proxySource) decimals(opts *bind.CallOpts) (uint8, error) {
+	return s.caller.Decimals(opts)
+}
+
+func (s *proxySource) description(opts *bind.CallOpts) (string, error) {
+	return s.caller.Description(opts)
+}
+
+func (s *proxySource) version(opts *bind.CallOpts) (*big.Int, error) {
+	return s.caller.Version(opts)
+}
+
+func (s *proxySource) phaseID(opts *bind.CallOpts) (uint16, error) {
+	return s.caller.PhaseId(opts)
+}
+
+func (s *proxySource) latestRoundData(opts *bind.CallOpts) (roundData, error) {
+	return s.caller.LatestRoundData(opts)
+}
+
+func (s *proxySource) roundData(opts *bind.CallOpts, roundID *big.Int) (roundData, error) {
+	return s.caller.GetRoundData(opts, roundID)
+}
+
+func (s *proxySource) proposedLatestRoundData(opts *bind.CallOpts) (roundData, error) {
+	return s.caller.ProposedLatestRoundData(opts)
+}
+
+type registrySource struct {
+	caller *registry.RegistryCaller
+	base   common.Address
+	quote  common.Address
+}
+
+func (s *registrySource) aggregator(opts *bind.CallOpts) (common.Address, error) {
+	return s.caller.GetFeed(opts, s.base, s.quote)
+}
+
+func (s *registrySource) proposedAggregator(opts *bind.CallOpts) (common.Address, error) {
+	return s.caller.GetProposedFeed(opts, s.base, s.quote)
+}
+
+func (s *registrySource) decimals(opts *bind.CallOpts) (uint8, error) {
+	return s.caller.Decimals(opts, s.base, s.quote)
+}
+
+func (s *registrySource) description(opts *bind.CallOpts) (string, error) {
+	return s.caller.Description(opts, s.base, s.quote)
+}
+
+func (s *registrySource) version(opts *bind.CallOpts) (*big.Int, error) {
+	return s.caller.Version(opts, s.base, s.quote)
+}
+
+func (s *registrySource) phaseID(opts *bind.CallOpts) (uint16, error) {
+	return s.caller.GetCurrentPhaseId(opts, s.base, s.quote)
+}
+
+func (s *registrySource) latestRoundData(opts *bind.CallOpts) (roundData, error) {
+	return s.caller.LatestRoundData(opts, s.base, s.quote)
+}
+
+func (s *registrySource) roundData(opts *bind.CallOpts, roundID *big.Int) (roundData, error) {
+	return s.caller.GetRoundData(opts, s.base, s.quote, roundID)
+}
+
+func (s *registrySource) proposedLatestRoundData(opts *bind.CallOpts) (roundData, error) {
+	return s.caller.ProposedLatestRoundData(opts, s.base, s.quote)
+}
@@ -0,0 +1,152 @@
+package monitor
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"hw-3/decimal"
+)
+
+// Round is the data of a feed round as returned by its proxy or the Feed
+// Registry.
+type Round struct {
+	Feed            Feed
+	RoundID         *big.Int
+	Answer          *big.Int
+	Decimals        uint8
+	Price           decimal.Decimal
+	StartedAt       time.Time
+	UpdatedAt       time.Time
+	AnsweredInRound *big.Int
+}
+
+// ProxyRoundID returns the round id a proxy or the Feed Registry reports for
+// round roundID of the aggregator of phase phaseID, which keeps the phase in
+// the upper bits.
+func ProxyRoundID(phaseID uint16, roundID *big.Int) *big.Int {
+	proxyRoundID := new(big.Int).Lsh(new(big.Int).SetUint64(uint64(phaseID)), 64)
+	return proxyRoundID.Or(proxyRoundID, roundID)
+}
+
+// latestPrices keeps the last price update of every feed.
+type latestPrices struct {
+	mu      sync.RWMutex
+	updates map[Feed]*PriceUpdate
+}
+
+func newLatestPrices() *latestPrices {
+	return &latestPrices{updates: make(map[Feed]*PriceUpdate)}
+}
+
+func (p *latestPrices) set(update *PriceUpdate) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.updates[update.Feed] = update
+}
+
+func (p *latestPrices) get(feed Feed) *PriceUpdate {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return p.updates[feed]
+}
+
+// Feeds returns the monitored feeds. Feeds given by an ENS name have the
+// address it currently resolves to, the zero address until it is resolved.
+func (m *Monitor) Feeds() []Feed {
+	feeds := make([]Feed, 0, len(m.config.Feeds))
+	for _, feed := range m.config.Feeds {
+		feeds = append(feeds, m.names.resolve(feed))
+	}
+	return feeds
+}
+
+// LatestPrice returns the last price update observed for feed, or nil if
+// none was observed since Run started.
+func (m *Monitor) LatestPrice(feed Feed) *PriceUpdate {
+	return m.latest.get(feed)
+}
+
+// Round queries a round of feed from its proxy or the Feed Registry, a nil
+// roundID queries the latest round.
+func (m *Monitor) Round(ctx context.Context, feed Feed, roundID *big.Int) (*Round, error) {
+	feed = m.names.resolve(feed)
+	source, err := newFeedSource(feed, m.backend)
+	if err != nil {
+		return nil, err
+	}
+	opts, cancel := m.callOpts(ctx)
+	defer cancel()
+	return queryRound(opts, source, feed, roundID)
+}
+
+func queryRound(opts *bind.CallOpts, source feedSource, feed Feed, roundID *big.Int) (*Round, error) {
+	decimals, err := source.decimals(opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed querying decimals: %w", err)
+	}
+	var data roundData
+	if roundID == nil {
+		data, err = source.latestRoundData(opts)
+	} else {
+		data, err = source.roundData(opts, roundID)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed querying round data: %w", err)
+	}
+	return &Round{
+		Feed:            feed,
+		RoundID:         data.RoundId,
+		Answer:          data.Answer,
+		Decimals:        decimals,
+		Price:           decimal.New(data.Answer, decimals),
+		StartedAt:       time.Unix(data.StartedAt.Int64(), 0),
+		UpdatedAt:       time.Unix(data.UpdatedAt.Int64(), 0),
+		AnsweredInRound: data.AnsweredInRound,
+	}, nil
+}
+
+// Snapshot is the latest round of a feed together with the metadata of its
+// proxy or registry entry.
+type Snapshot struct {
+	Round
+	Description string
+	Version     *big.Int
+	PhaseID     uint16
+}
+
+// QuerySnapshot queries the latest round and the metadata of feed once,
+// without subscribing to anything. An ENS name of feed is resolved first.
+func QuerySnapshot(ctx context.Context, backend bind.ContractCaller, feed Feed) (*Snapshot, error) {
+	opts := &bind.CallOpts{Context: ctx}
+	if feed.Name != "" {
+		address, err := ResolveName(opts, backend, feed.Name)
+		if err != nil {
+			return nil, fmt.Errorf("failed resolving %s: %w", feed.Name, err)
+		}
+		feed.Address = address
+	}
+	source, err := newFeedSource(feed, backend)
+	if err != nil {
+		return nil, err
+	}
+
+	round, err := queryRound(opts, source, feed, nil)
+	if err != nil {
+		return nil, err
+	}
+	snapshot := &Snapshot{Round: *round}
+	if snapshot.Description, err = source.description(opts); err != nil {
+		return nil, fmt.Errorf("failed querying description: %w", err)
+	}
+	if snapshot.Version, err = source.version(opts); err != nil {
+		return nil, fmt.Errorf("failed querying version: %w", err)
+	}
+	if snapshot.PhaseID, err = source.phaseID(opts); err != nil {
+		return nil, fmt.Errorf("failed querying phase id: %w", err)
+	}
+	return snapshot, nil
+}
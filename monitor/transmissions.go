@@ -0,0 +1,59 @@
+package monitor
+
+import (
+	"math"
+
+	"hw-3/decimal"
+	"hw-3/ocr"
+)
+
+var (
+	ocrABI            = mustParseABI(ocr.OffchainAggregatorMetaData.ABI)
+	newTransmissionID = ocrABI.Events["NewTransmission"].ID
+)
+
+// newTransmission summarizes the observations of a NewTransmission event.
+// OCR reports carry the observations sorted, the answer is their median.
+func newTransmission(feed Feed, decimals uint8, ev *ocr.OffchainAggregatorNewTransmission) (*Transmission, error) {
+	transmission := &Transmission{
+		Feed:         feed,
+		Aggregator:   ev.Raw.Address,
+		RoundID:      ev.AggregatorRoundId,
+		Answer:       ev.Answer,
+		Decimals:     decimals,
+		Price:        decimal.New(ev.Answer, decimals),
+		Transmitter:  ev.Transmitter,
+		Observers:    make([]int, 0, len(ev.Observers)),
+		Observations: make([]decimal.Decimal, 0, len(ev.Observations)),
+		Log:          ev.Raw,
+	}
+	for _, observer := range ev.Observers {
+		transmission.Observers = append(transmission.Observers, int(observer))
+	}
+	for _, observation := range ev.Observations {
+		transmission.Observations = append(transmission.Observations, decimal.New(observation, decimals))
+	}
+	if len(transmission.Observations) == 0 {
+		return transmission, nil
+	}
+
+	lowest, highest := transmission.Observations[0], transmission.Observations[0]
+	for _, observation := range transmission.Observations[1:] {
+		if observation.Cmp(lowest) < 0 {
+			lowest = observation
+		}
+		if observation.Cmp(highest) > 0 {
+			highest = observation
+		}
+	}
+	transmission.Median = transmission.Observations[len(transmission.Observations)/2]
+	transmission.Spread = highest.Sub(lowest)
+	if transmission.Median.Sign() != 0 {
+		spread, err := transmission.Spread.Quo(transmission.Median, deviationScale)
+		if err != nil {
+			return nil, err
+		}
+		transmission.SpreadPercent = math.Abs(spread.Float64()) * 100
+	}
+	return transmission, nil
+}
@@ -0,0 +1,86 @@
+package monitor_test
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"hw-3/monitor"
+	"hw-3/ocr"
+)
+
+var transmitterAddress = common.HexToAddress("0x0fd7F61b3cC0C5CC4F9C6E8fB85C06CA8d2C4c3c")
+
+// newTransmission builds the log of a NewTransmission event included in block.
+func newTransmission(t *testing.T, block *types.Header, roundID int64, observers []byte, observations ...int64) types.Log {
+	t.Helper()
+	event := parseABI(t, ocr.OffchainAggregatorMetaData.ABI).Events["NewTransmission"]
+	values := make([]*big.Int, 0, len(observations))
+	for _, observation := range observations {
+		values = append(values, big.NewInt(observation))
+	}
+	median := values[len(values)/2]
+	data, err := event.Inputs.NonIndexed().Pack(median, transmitterAddress, values, observers, [32]byte{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return types.Log{
+		Address:     aggregatorAddress,
+		Topics:      []common.Hash{event.ID, common.BigToHash(big.NewInt(roundID))},
+		Data:        data,
+		BlockNumber: block.Number.Uint64(),
+		BlockHash:   block.Hash(),
+		TxHash:      common.BigToHash(big.NewInt(roundID)),
+	}
+}
+
+func TestTransmission(t *testing.T) {
+	node, events := scriptedNode(t, scriptedFeed())
+
+	block := node.Mine()
+	node.PushLogs(
+		newTransmission(t, block, 42, []byte{3, 0, 7, 1}, 1990_00000000, 1999_00000000, 2000_00000000, 2010_00000000),
+		answerUpdated(t, block, 2000_00000000, 42),
+	)
+
+	transmission := nextEvent[*monitor.Transmission](t, events)
+	if transmission.RoundID != 42 || transmission.Transmitter != transmitterAddress {
+		t.Errorf("unexpected round %d transmitted by %s", transmission.RoundID, transmission.Transmitter)
+	}
+	if len(transmission.Observers) != 4 || transmission.Observers[0] != 3 || transmission.Observers[2] != 7 {
+		t.Errorf("unexpected observers %v", transmission.Observers)
+	}
+	if got := transmission.Median.String(); got != "2000.00000000" {
+		t.Errorf("expected median 2000.00000000, got %s", got)
+	}
+	if got := transmission.Spread.String(); got != "20.00000000" {
+		t.Errorf("expected spread 20.00000000, got %s", got)
+	}
+	if transmission.SpreadPercent != 1 {
+		t.Errorf("expected 1%% spread, got %f", transmission.SpreadPercent)
+	}
+	if transmission.Unreliable {
+		t.Error("expected transmission to be reliable without a sequencer uptime feed")
+	}
+	if price := nextEvent[*monitor.PriceUpdate](t, events); price.RoundID.Int64() != 42 {
+		t.Errorf("expected price update of round 42, got %s", price.RoundID)
+	}
+}
+
+func TestTransmissionSequencerGracePeriod(t *testing.T) {
+	node := feedNode(t)
+	// The sequencer came back up half an hour ago
+	setSequencer(t, node, true, time.Now().Add(-30*time.Minute))
+	config := scriptedFeed()
+	config.SequencerUptimeFeed = uptimeAddress
+	config.SequencerGracePeriod = time.Hour
+	events := startMonitor(t, dialNode(t, node), config)
+
+	block := node.Mine()
+	node.PushLogs(newTransmission(t, block, 42, []byte{0, 1, 2}, 1999_00000000, 2000_00000000, 2001_00000000))
+	if transmission := nextEvent[*monitor.Transmission](t, events); !transmission.Unreliable {
+		t.Error("expected transmission in the grace period to be unreliable")
+	}
+}
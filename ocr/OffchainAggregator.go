@@ -0,0 +1,662 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package ocr
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+)
+
+// OffchainAggregatorMetaData contains all meta data concerning the OffchainAggregator contract.
+var OffchainAggregatorMetaData = &bind.MetaData{
+	ABI: "[{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"uint32\",\"name\":\"aggregatorRoundId\",\"type\":\"uint32\"},{\"indexed\":false,\"internalType\":\"int192\",\"name\":\"answer\",\"type\":\"int192\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"transmitter\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"int192[]\",\"name\":\"observations\",\"type\":\"int192[]\"},{\"indexed\":false,\"internalType\":\"bytes\",\"name\":\"observers\",\"type\":\"bytes\"},{\"indexed\":false,\"internalType\":\"bytes32\",\"name\":\"rawReportContext\",\"type\":\"bytes32\"}],\"name\":\"NewTransmission\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint32\",\"name\":\"previousConfigBlockNumber\",\"type\":\"uint32\"},{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"configCount\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"address[]\",\"name\":\"signers\",\"type\":\"address[]\"},{\"indexed\":false,\"internalType\":\"address[]\",\"name\":\"transmitters\",\"type\":\"address[]\"},{\"indexed\":false,\"internalType\":\"uint8\",\"name\":\"threshold\",\"type\":\"uint8\"},{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"encodedConfigVersion\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"bytes\",\"name\":\"encoded\",\"type\":\"bytes\"}],\"name\":\"ConfigSet\",\"type\":\"event\"},{\"inputs\":[],\"name\":\"transmitters\",\"outputs\":[{\"internalType\":\"address[]\",\"name\":\"\",\"type\":\"address[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"latestConfigDetails\",\"outputs\":[{\"internalType\":\"uint32\",\"name\":\"configCount\",\"type\":\"uint32\"},{\"internalType\":\"uint32\",\"name\":\"blockNumber\",\"type\":\"uint32\"},{\"internalType\":\"bytes16\",\"name\":\"configDigest\",\"type\":\"bytes16\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"latestTransmissionDetails\",\"outputs\":[{\"internalType\":\"bytes16\",\"name\":\"configDigest\",\"type\":\"bytes16\"},{\"internalType\":\"uint32\",\"name\":\"epoch\",\"type\":\"uint32\"},{\"internalType\":\"uint8\",\"name\":\"round\",\"type\":\"uint8\"},{\"internalType\":\"int192\",\"name\":\"latestAnswer\",\"type\":\"int192\"},{\"internalType\":\"uint64\",\"name\":\"latestTimestamp\",\"type\":\"uint64\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"decimals\",\"outputs\":[{\"internalType\":\"uint8\",\"name\":\"\",\"type\":\"uint8\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes\",\"name\":\"_report\",\"type\":\"bytes\"},{\"internalType\":\"bytes32[]\",\"name\":\"_rs\",\"type\":\"bytes32[]\"},{\"internalType\":\"bytes32[]\",\"name\":\"_ss\",\"type\":\"bytes32[]\"},{\"internalType\":\"bytes32\",\"name\":\"_rawVs\",\"type\":\"bytes32\"}],\"name\":\"transmit\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]",
+}
+
+// OffchainAggregatorABI is the input ABI used to generate the binding from.
+// Deprecated: Use OffchainAggregatorMetaData.ABI instead.
+var OffchainAggregatorABI = OffchainAggregatorMetaData.ABI
+
+// OffchainAggregator is an auto generated Go binding around an Ethereum contract.
+type OffchainAggregator struct {
+	OffchainAggregatorCaller     // Read-only binding to the contract
+	OffchainAggregatorTransactor // Write-only binding to the contract
+	OffchainAggregatorFilterer   // Log filterer for contract events
+}
+
+// OffchainAggregatorCaller is an auto generated read-only Go binding around an Ethereum contract.
+type OffchainAggregatorCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// OffchainAggregatorTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type OffchainAggregatorTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// OffchainAggregatorFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type OffchainAggregatorFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// OffchainAggregatorSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type OffchainAggregatorSession struct {
+	Contract     *OffchainAggregator // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts       // Call options to use throughout this session
+	TransactOpts bind.TransactOpts   // Transaction auth options to use throughout this session
+}
+
+// OffchainAggregatorCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type OffchainAggregatorCallerSession struct {
+	Contract *OffchainAggregatorCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts             // Call options to use throughout this session
+}
+
+// OffchainAggregatorTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type OffchainAggregatorTransactorSession struct {
+	Contract     *OffchainAggregatorTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts             // Transaction auth options to use throughout this session
+}
+
+// OffchainAggregatorRaw is an auto generated low-level Go binding around an Ethereum contract.
+type OffchainAggregatorRaw struct {
+	Contract *OffchainAggregator // Generic contract binding to access the raw methods on
+}
+
+// OffchainAggregatorCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type OffchainAggregatorCallerRaw struct {
+	Contract *OffchainAggregatorCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// OffchainAggregatorTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type OffchainAggregatorTransactorRaw struct {
+	Contract *OffchainAggregatorTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewOffchainAggregator creates a new instance of OffchainAggregator, bound to a specific deployed contract.
+func NewOffchainAggregator(address common.Address, backend bind.ContractBackend) (*OffchainAggregator, error) {
+	contract, err := bindOffchainAggregator(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &OffchainAggregator{OffchainAggregatorCaller: OffchainAggregatorCaller{contract: contract}, OffchainAggregatorTransactor: OffchainAggregatorTransactor{contract: contract}, OffchainAggregatorFilterer: OffchainAggregatorFilterer{contract: contract}}, nil
+}
+
+// NewOffchainAggregatorCaller creates a new read-only instance of OffchainAggregator, bound to a specific deployed contract.
+func NewOffchainAggregatorCaller(address common.Address, caller bind.ContractCaller) (*OffchainAggregatorCaller, error) {
+	contract, err := bindOffchainAggregator(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &OffchainAggregatorCaller{contract: contract}, nil
+}
+
+// NewOffchainAggregatorTransactor creates a new write-only instance of OffchainAggregator, bound to a specific deployed contract.
+func NewOffchainAggregatorTransactor(address common.Address, transactor bind.ContractTransactor) (*OffchainAggregatorTransactor, error) {
+	contract, err := bindOffchainAggregator(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &OffchainAggregatorTransactor{contract: contract}, nil
+}
+
+// NewOffchainAggregatorFilterer creates a new log filterer instance of OffchainAggregator, bound to a specific deployed contract.
+func NewOffchainAggregatorFilterer(address common.Address, filterer bind.ContractFilterer) (*OffchainAggregatorFilterer, error) {
+	contract, err := bindOffchainAggregator(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &OffchainAggregatorFilterer{contract: contract}, nil
+}
+
+// bindOffchainAggregator binds a generic wrapper to an already deployed contract.
+func bindOffchainAggregator(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := abi.JSON(strings.NewReader(OffchainAggregatorABI))
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_OffchainAggregator *OffchainAggregatorRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _OffchainAggregator.Contract.OffchainAggregatorCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_OffchainAggregator *OffchainAggregatorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _OffchainAggregator.Contract.OffchainAggregatorTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_OffchainAggregator *OffchainAggregatorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _OffchainAggregator.Contract.OffchainAggregatorTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_OffchainAggregator *OffchainAggregatorCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _OffchainAggregator.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_OffchainAggregator *OffchainAggregatorTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _OffchainAggregator.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_OffchainAggregator *OffchainAggregatorTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _OffchainAggregator.Contract.contract.Transact(opts, method, params...)
+}
+
+// Decimals is a free data retrieval call binding the contract method 0x313ce567.
+//
+// Solidity: function decimals() view returns(uint8)
+func (_OffchainAggregator *OffchainAggregatorCaller) Decimals(opts *bind.CallOpts) (uint8, error) {
+	var out []interface{}
+	err := _OffchainAggregator.contract.Call(opts, &out, "decimals")
+
+	if err != nil {
+		return *new(uint8), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint8)).(*uint8)
+
+	return out0, err
+
+}
+
+// Decimals is a free data retrieval call binding the contract method 0x313ce567.
+//
+// Solidity: function decimals() view returns(uint8)
+func (_OffchainAggregator *OffchainAggregatorSession) Decimals() (uint8, error) {
+	return _OffchainAggregator.Contract.Decimals(&_OffchainAggregator.CallOpts)
+}
+
+// Decimals is a free data retrieval call binding the contract method 0x313ce567.
+//
+// Solidity: function decimals() view returns(uint8)
+func (_OffchainAggregator *OffchainAggregatorCallerSession) Decimals() (uint8, error) {
+	return _OffchainAggregator.Contract.Decimals(&_OffchainAggregator.CallOpts)
+}
+
+// LatestConfigDetails is a free data retrieval call binding the contract method 0x81ff7048.
+//
+// Solidity: function latestConfigDetails() view returns(uint32 configCount, uint32 blockNumber, bytes16 configDigest)
+func (_OffchainAggregator *OffchainAggregatorCaller) LatestConfigDetails(opts *bind.CallOpts) (struct {
+	ConfigCount  uint32
+	BlockNumber  uint32
+	ConfigDigest [16]byte
+}, error) {
+	var out []interface{}
+	err := _OffchainAggregator.contract.Call(opts, &out, "latestConfigDetails")
+
+	outstruct := new(struct {
+		ConfigCount  uint32
+		BlockNumber  uint32
+		ConfigDigest [16]byte
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.ConfigCount = *abi.ConvertType(out[0], new(uint32)).(*uint32)
+	outstruct.BlockNumber = *abi.ConvertType(out[1], new(uint32)).(*uint32)
+	outstruct.ConfigDigest = *abi.ConvertType(out[2], new([16]byte)).(*[16]byte)
+
+	return *outstruct, err
+
+}
+
+// LatestConfigDetails is a free data retrieval call binding the contract method 0x81ff7048.
+//
+// Solidity: function latestConfigDetails() view returns(uint32 configCount, uint32 blockNumber, bytes16 configDigest)
+func (_OffchainAggregator *OffchainAggregatorSession) LatestConfigDetails() (struct {
+	ConfigCount  uint32
+	BlockNumber  uint32
+	ConfigDigest [16]byte
+}, error) {
+	return _OffchainAggregator.Contract.LatestConfigDetails(&_OffchainAggregator.CallOpts)
+}
+
+// LatestConfigDetails is a free data retrieval call binding the contract method 0x81ff7048.
+//
+// Solidity: function latestConfigDetails() view returns(uint32 configCount, uint32 blockNumber, bytes16 configDigest)
+func (_OffchainAggregator *OffchainAggregatorCallerSession) LatestConfigDetails() (struct {
+	ConfigCount  uint32
+	BlockNumber  uint32
+	ConfigDigest [16]byte
+}, error) {
+	return _OffchainAggregator.Contract.LatestConfigDetails(&_OffchainAggregator.CallOpts)
+}
+
+// LatestTransmissionDetails is a free data retrieval call binding the contract method 0xe5fe4577.
+//
+// Solidity: function latestTransmissionDetails() view returns(bytes16 configDigest, uint32 epoch, uint8 round, int192 latestAnswer, uint64 latestTimestamp)
+func (_OffchainAggregator *OffchainAggregatorCaller) LatestTransmissionDetails(opts *bind.CallOpts) (struct {
+	ConfigDigest    [16]byte
+	Epoch           uint32
+	Round           uint8
+	LatestAnswer    *big.Int
+	LatestTimestamp uint64
+}, error) {
+	var out []interface{}
+	err := _OffchainAggregator.contract.Call(opts, &out, "latestTransmissionDetails")
+
+	outstruct := new(struct {
+		ConfigDigest    [16]byte
+		Epoch           uint32
+		Round           uint8
+		LatestAnswer    *big.Int
+		LatestTimestamp uint64
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.ConfigDigest = *abi.ConvertType(out[0], new([16]byte)).(*[16]byte)
+	outstruct.Epoch = *abi.ConvertType(out[1], new(uint32)).(*uint32)
+	outstruct.Round = *abi.ConvertType(out[2], new(uint8)).(*uint8)
+	outstruct.LatestAnswer = *abi.ConvertType(out[3], new(*big.Int)).(**big.Int)
+	outstruct.LatestTimestamp = *abi.ConvertType(out[4], new(uint64)).(*uint64)
+
+	return *outstruct, err
+
+}
+
+// LatestTransmissionDetails is a free data retrieval call binding the contract method 0xe5fe4577.
+//
+// Solidity: function latestTransmissionDetails() view returns(bytes16 configDigest, uint32 epoch, uint8 round, int192 latestAnswer, uint64 latestTimestamp)
+func (_OffchainAggregator *OffchainAggregatorSession) LatestTransmissionDetails() (struct {
+	ConfigDigest    [16]byte
+	Epoch           uint32
+	Round           uint8
+	LatestAnswer    *big.Int
+	LatestTimestamp uint64
+}, error) {
+	return _OffchainAggregator.Contract.LatestTransmissionDetails(&_OffchainAggregator.CallOpts)
+}
+
+// LatestTransmissionDetails is a free data retrieval call binding the contract method 0xe5fe4577.
+//
+// Solidity: function latestTransmissionDetails() view returns(bytes16 configDigest, uint32 epoch, uint8 round, int192 latestAnswer, uint64 latestTimestamp)
+func (_OffchainAggregator *OffchainAggregatorCallerSession) LatestTransmissionDetails() (struct {
+	ConfigDigest    [16]byte
+	Epoch           uint32
+	Round           uint8
+	LatestAnswer    *big.Int
+	LatestTimestamp uint64
+}, error) {
+	return _OffchainAggregator.Contract.LatestTransmissionDetails(&_OffchainAggregator.CallOpts)
+}
+
+// Transmitters is a free data retrieval call binding the contract method 0x81411834.
+//
+// Solidity: function transmitters() view returns(address[])
+func (_OffchainAggregator *OffchainAggregatorCaller) Transmitters(opts *bind.CallOpts) ([]common.Address, error) {
+	var out []interface{}
+	err := _OffchainAggregator.contract.Call(opts, &out, "transmitters")
+
+	if err != nil {
+		return *new([]common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]common.Address)).(*[]common.Address)
+
+	return out0, err
+
+}
+
+// Transmitters is a free data retrieval call binding the contract method 0x81411834.
+//
+// Solidity: function transmitters() view returns(address[])
+func (_OffchainAggregator *OffchainAggregatorSession) Transmitters() ([]common.Address, error) {
+	return _OffchainAggregator.Contract.Transmitters(&_OffchainAggregator.CallOpts)
+}
+
+// Transmitters is a free data retrieval call binding the contract method 0x81411834.
+//
+// Solidity: function transmitters() view returns(address[])
+func (_OffchainAggregator *OffchainAggregatorCallerSession) Transmitters() ([]common.Address, error) {
+	return _OffchainAggregator.Contract.Transmitters(&_OffchainAggregator.CallOpts)
+}
+
+// Transmit is a paid mutator transaction binding the contract method 0xc9807539.
+//
+// Solidity: function transmit(bytes _report, bytes32[] _rs, bytes32[] _ss, bytes32 _rawVs) returns()
+func (_OffchainAggregator *OffchainAggregatorTransactor) Transmit(opts *bind.TransactOpts, _report []byte, _rs [][32]byte, _ss [][32]byte, _rawVs [32]byte) (*types.Transaction, error) {
+	return _OffchainAggregator.contract.Transact(opts, "transmit", _report, _rs, _ss, _rawVs)
+}
+
+// Transmit is a paid mutator transaction binding the contract method 0xc9807539.
+//
+// Solidity: function transmit(bytes _report, bytes32[] _rs, bytes32[] _ss, bytes32 _rawVs) returns()
+func (_OffchainAggregator *OffchainAggregatorSession) Transmit(_report []byte, _rs [][32]byte, _ss [][32]byte, _rawVs [32]byte) (*types.Transaction, error) {
+	return _OffchainAggregator.Contract.Transmit(&_OffchainAggregator.TransactOpts, _report, _rs, _ss, _rawVs)
+}
+
+// Transmit is a paid mutator transaction binding the contract method 0xc9807539.
+//
+// Solidity: function transmit(bytes _report, bytes32[] _rs, bytes32[] _ss, bytes32 _rawVs) returns()
+func (_OffchainAggregator *OffchainAggregatorTransactorSession) Transmit(_report []byte, _rs [][32]byte, _ss [][32]byte, _rawVs [32]byte) (*types.Transaction, error) {
+	return _OffchainAggregator.Contract.Transmit(&_OffchainAggregator.TransactOpts, _report, _rs, _ss, _rawVs)
+}
+
+// OffchainAggregatorConfigSetIterator is returned from FilterConfigSet and is used to iterate over the raw logs and unpacked data for ConfigSet events raised by the OffchainAggregator contract.
+type OffchainAggregatorConfigSetIterator struct {
+	Event *OffchainAggregatorConfigSet // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *OffchainAggregatorConfigSetIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(OffchainAggregatorConfigSet)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(OffchainAggregatorConfigSet)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *OffchainAggregatorConfigSetIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *OffchainAggregatorConfigSetIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// OffchainAggregatorConfigSet represents a ConfigSet event raised by the OffchainAggregator contract.
+type OffchainAggregatorConfigSet struct {
+	PreviousConfigBlockNumber uint32
+	ConfigCount               uint64
+	Signers                   []common.Address
+	Transmitters              []common.Address
+	Threshold                 uint8
+	EncodedConfigVersion      uint64
+	Encoded                   []byte
+	Raw                       types.Log // Blockchain specific contextual infos
+}
+
+// FilterConfigSet is a free log retrieval operation binding the contract event 0x25d719d88a4512dd76c7442b910a83360845505894eb444ef299409e180f8fb9.
+//
+// Solidity: event ConfigSet(uint32 previousConfigBlockNumber, uint64 configCount, address[] signers, address[] transmitters, uint8 threshold, uint64 encodedConfigVersion, bytes encoded)
+func (_OffchainAggregator *OffchainAggregatorFilterer) FilterConfigSet(opts *bind.FilterOpts) (*OffchainAggregatorConfigSetIterator, error) {
+
+	logs, sub, err := _OffchainAggregator.contract.FilterLogs(opts, "ConfigSet")
+	if err != nil {
+		return nil, err
+	}
+	return &OffchainAggregatorConfigSetIterator{contract: _OffchainAggregator.contract, event: "ConfigSet", logs: logs, sub: sub}, nil
+}
+
+// WatchConfigSet is a free log subscription operation binding the contract event 0x25d719d88a4512dd76c7442b910a83360845505894eb444ef299409e180f8fb9.
+//
+// Solidity: event ConfigSet(uint32 previousConfigBlockNumber, uint64 configCount, address[] signers, address[] transmitters, uint8 threshold, uint64 encodedConfigVersion, bytes encoded)
+func (_OffchainAggregator *OffchainAggregatorFilterer) WatchConfigSet(opts *bind.WatchOpts, sink chan<- *OffchainAggregatorConfigSet) (event.Subscription, error) {
+
+	logs, sub, err := _OffchainAggregator.contract.WatchLogs(opts, "ConfigSet")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(OffchainAggregatorConfigSet)
+				if err := _OffchainAggregator.contract.UnpackLog(event, "ConfigSet", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseConfigSet is a log parse operation binding the contract event 0x25d719d88a4512dd76c7442b910a83360845505894eb444ef299409e180f8fb9.
+//
+// Solidity: event ConfigSet(uint32 previousConfigBlockNumber, uint64 configCount, address[] signers, address[] transmitters, uint8 threshold, uint64 encodedConfigVersion, bytes encoded)
+func (_OffchainAggregator *OffchainAggregatorFilterer) ParseConfigSet(log types.Log) (*OffchainAggregatorConfigSet, error) {
+	event := new(OffchainAggregatorConfigSet)
+	if err := _OffchainAggregator.contract.UnpackLog(event, "ConfigSet", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// OffchainAggregatorNewTransmissionIterator is returned from FilterNewTransmission and is used to iterate over the raw logs and unpacked data for NewTransmission events raised by the OffchainAggregator contract.
+type OffchainAggregatorNewTransmissionIterator struct {
+	Event *OffchainAggregatorNewTransmission // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *OffchainAggregatorNewTransmissionIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(OffchainAggregatorNewTransmission)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(OffchainAggregatorNewTransmission)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *OffchainAggregatorNewTransmissionIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *OffchainAggregatorNewTransmissionIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// OffchainAggregatorNewTransmission represents a NewTransmission event raised by the OffchainAggregator contract.
+type OffchainAggregatorNewTransmission struct {
+	AggregatorRoundId uint32
+	Answer            *big.Int
+	Transmitter       common.Address
+	Observations      []*big.Int
+	Observers         []byte
+	RawReportContext  [32]byte
+	Raw               types.Log // Blockchain specific contextual infos
+}
+
+// FilterNewTransmission is a free log retrieval operation binding the contract event 0xf6a97944f31ea060dfde0566e4167c1a1082551e64b60ecb14d599a9d023d451.
+//
+// Solidity: event NewTransmission(uint32 indexed aggregatorRoundId, int192 answer, address transmitter, int192[] observations, bytes observers, bytes32 rawReportContext)
+func (_OffchainAggregator *OffchainAggregatorFilterer) FilterNewTransmission(opts *bind.FilterOpts, aggregatorRoundId []uint32) (*OffchainAggregatorNewTransmissionIterator, error) {
+
+	var aggregatorRoundIdRule []interface{}
+	for _, aggregatorRoundIdItem := range aggregatorRoundId {
+		aggregatorRoundIdRule = append(aggregatorRoundIdRule, aggregatorRoundIdItem)
+	}
+
+	logs, sub, err := _OffchainAggregator.contract.FilterLogs(opts, "NewTransmission", aggregatorRoundIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return &OffchainAggregatorNewTransmissionIterator{contract: _OffchainAggregator.contract, event: "NewTransmission", logs: logs, sub: sub}, nil
+}
+
+// WatchNewTransmission is a free log subscription operation binding the contract event 0xf6a97944f31ea060dfde0566e4167c1a1082551e64b60ecb14d599a9d023d451.
+//
+// Solidity: event NewTransmission(uint32 indexed aggregatorRoundId, int192 answer, address transmitter, int192[] observations, bytes observers, bytes32 rawReportContext)
+func (_OffchainAggregator *OffchainAggregatorFilterer) WatchNewTransmission(opts *bind.WatchOpts, sink chan<- *OffchainAggregatorNewTransmission, aggregatorRoundId []uint32) (event.Subscription, error) {
+
+	var aggregatorRoundIdRule []interface{}
+	for _, aggregatorRoundIdItem := range aggregatorRoundId {
+		aggregatorRoundIdRule = append(aggregatorRoundIdRule, aggregatorRoundIdItem)
+	}
+
+	logs, sub, err := _OffchainAggregator.contract.WatchLogs(opts, "NewTransmission", aggregatorRoundIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(OffchainAggregatorNewTransmission)
+				if err := _OffchainAggregator.contract.UnpackLog(event, "NewTransmission", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseNewTransmission is a log parse operation binding the contract event 0xf6a97944f31ea060dfde0566e4167c1a1082551e64b60ecb14d599a9d023d451.
+//
+// Solidity: event NewTransmission(uint32 indexed aggregatorRoundId, int192 answer, address transmitter, int192[] observations, bytes observers, bytes32 rawReportContext)
+func (_OffchainAggregator *OffchainAggregatorFilterer) ParseNewTransmission(log types.Log) (*OffchainAggregatorNewTransmission, error) {
+	event := new(OffchainAggregatorNewTransmission)
+	if err := _OffchainAggregator.contract.UnpackLog(event, "NewTransmission", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
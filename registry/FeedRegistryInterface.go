@@ -0,0 +1,1418 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package registry
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+)
+
+// RegistryMetaData contains all meta data concerning the Registry contract.
+var RegistryMetaData = &bind.MetaData{
+	ABI: "[{\"anonymous\":false,\"inputs\":[{\"internalType\":\"address\",\"name\":\"asset\",\"type\":\"address\",\"indexed\":true},{\"internalType\":\"address\",\"name\":\"denomination\",\"type\":\"address\",\"indexed\":true},{\"internalType\":\"address\",\"name\":\"latestAggregator\",\"type\":\"address\",\"indexed\":true},{\"internalType\":\"address\",\"name\":\"previousAggregator\",\"type\":\"address\",\"indexed\":false},{\"internalType\":\"uint16\",\"name\":\"nextPhaseId\",\"type\":\"uint16\",\"indexed\":false},{\"internalType\":\"address\",\"name\":\"sender\",\"type\":\"address\",\"indexed\":false}],\"name\":\"FeedConfirmed\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"internalType\":\"address\",\"name\":\"asset\",\"type\":\"address\",\"indexed\":true},{\"internalType\":\"address\",\"name\":\"denomination\",\"type\":\"address\",\"indexed\":true},{\"internalType\":\"address\",\"name\":\"proposedAggregator\",\"type\":\"address\",\"indexed\":true},{\"internalType\":\"address\",\"name\":\"currentAggregator\",\"type\":\"address\",\"indexed\":false},{\"internalType\":\"address\",\"name\":\"sender\",\"type\":\"address\",\"indexed\":false}],\"name\":\"FeedProposed\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\",\"indexed\":true},{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\",\"indexed\":true}],\"name\":\"OwnershipTransferRequested\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\",\"indexed\":true},{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\",\"indexed\":true}],\"name\":\"OwnershipTransferred\",\"type\":\"event\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"base\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"quote\",\"type\":\"address\"}],\"name\":\"decimals\",\"outputs\":[{\"internalType\":\"uint8\",\"name\":\"\",\"type\":\"uint8\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"base\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"quote\",\"type\":\"address\"}],\"name\":\"description\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"base\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"quote\",\"type\":\"address\"}],\"name\":\"version\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"base\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"quote\",\"type\":\"address\"}],\"name\":\"latestRoundData\",\"outputs\":[{\"internalType\":\"uint80\",\"name\":\"roundId\",\"type\":\"uint80\"},{\"internalType\":\"int256\",\"name\":\"answer\",\"type\":\"int256\"},{\"internalType\":\"uint256\",\"name\":\"startedAt\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"updatedAt\",\"type\":\"uint256\"},{\"internalType\":\"uint80\",\"name\":\"answeredInRound\",\"type\":\"uint80\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"base\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"quote\",\"type\":\"address\"},{\"internalType\":\"uint80\",\"name\":\"_roundId\",\"type\":\"uint80\"}],\"name\":\"getRoundData\",\"outputs\":[{\"internalType\":\"uint80\",\"name\":\"roundId\",\"type\":\"uint80\"},{\"internalType\":\"int256\",\"name\":\"answer\",\"type\":\"int256\"},{\"internalType\":\"uint256\",\"name\":\"startedAt\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"updatedAt\",\"type\":\"uint256\"},{\"internalType\":\"uint80\",\"name\":\"answeredInRound\",\"type\":\"uint80\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"base\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"quote\",\"type\":\"address\"}],\"name\":\"getFeed\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"aggregator\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"base\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"quote\",\"type\":\"address\"},{\"internalType\":\"uint16\",\"name\":\"phaseId\",\"type\":\"uint16\"}],\"name\":\"getPhaseFeed\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"aggregator\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"base\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"quote\",\"type\":\"address\"}],\"name\":\"getProposedFeed\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"proposedAggregator\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"base\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"quote\",\"type\":\"address\"}],\"name\":\"proposedLatestRoundData\",\"outputs\":[{\"internalType\":\"uint80\",\"name\":\"roundId\",\"type\":\"uint80\"},{\"internalType\":\"int256\",\"name\":\"answer\",\"type\":\"int256\"},{\"internalType\":\"uint256\",\"name\":\"startedAt\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"updatedAt\",\"type\":\"uint256\"},{\"internalType\":\"uint80\",\"name\":\"answeredInRound\",\"type\":\"uint80\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"base\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"quote\",\"type\":\"address\"},{\"internalType\":\"uint80\",\"name\":\"roundId\",\"type\":\"uint80\"}],\"name\":\"proposedGetRoundData\",\"outputs\":[{\"internalType\":\"uint80\",\"name\":\"roundId\",\"type\":\"uint80\"},{\"internalType\":\"int256\",\"name\":\"answer\",\"type\":\"int256\"},{\"internalType\":\"uint256\",\"name\":\"startedAt\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"updatedAt\",\"type\":\"uint256\"},{\"internalType\":\"uint80\",\"name\":\"answeredInRound\",\"type\":\"uint80\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"base\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"quote\",\"type\":\"address\"}],\"name\":\"getCurrentPhaseId\",\"outputs\":[{\"internalType\":\"uint16\",\"name\":\"currentPhaseId\",\"type\":\"uint16\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"aggregator\",\"type\":\"address\"}],\"name\":\"isFeedEnabled\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"owner\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"base\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"quote\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"aggregator\",\"type\":\"address\"}],\"name\":\"proposeFeed\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"base\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"quote\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"aggregator\",\"type\":\"address\"}],\"name\":\"confirmFeed\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"}],\"name\":\"transferOwnership\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"acceptOwnership\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]",
+}
+
+// RegistryABI is the input ABI used to generate the binding from.
+// Deprecated: Use RegistryMetaData.ABI instead.
+var RegistryABI = RegistryMetaData.ABI
+
+// Registry is an auto generated Go binding around an Ethereum contract.
+type Registry struct {
+	RegistryCaller     // Read-only binding to the contract
+	RegistryTransactor // Write-only binding to the contract
+	RegistryFilterer   // Log filterer for contract events
+}
+
+// RegistryCaller is an auto generated read-only Go binding around an Ethereum contract.
+type RegistryCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// RegistryTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type RegistryTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// RegistryFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type RegistryFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// RegistrySession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type RegistrySession struct {
+	Contract     *Registry         // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// RegistryCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type RegistryCallerSession struct {
+	Contract *RegistryCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts   // Call options to use throughout this session
+}
+
+// RegistryTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type RegistryTransactorSession struct {
+	Contract     *RegistryTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts   // Transaction auth options to use throughout this session
+}
+
+// RegistryRaw is an auto generated low-level Go binding around an Ethereum contract.
+type RegistryRaw struct {
+	Contract *Registry // Generic contract binding to access the raw methods on
+}
+
+// RegistryCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type RegistryCallerRaw struct {
+	Contract *RegistryCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// RegistryTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type RegistryTransactorRaw struct {
+	Contract *RegistryTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewRegistry creates a new instance of Registry, bound to a specific deployed contract.
+func NewRegistry(address common.Address, backend bind.ContractBackend) (*Registry, error) {
+	contract, err := bindRegistry(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &Registry{RegistryCaller: RegistryCaller{contract: contract}, RegistryTransactor: RegistryTransactor{contract: contract}, RegistryFilterer: RegistryFilterer{contract: contract}}, nil
+}
+
+// NewRegistryCaller creates a new read-only instance of Registry, bound to a specific deployed contract.
+func NewRegistryCaller(address common.Address, caller bind.ContractCaller) (*RegistryCaller, error) {
+	contract, err := bindRegistry(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &RegistryCaller{contract: contract}, nil
+}
+
+// NewRegistryTransactor creates a new write-only instance of Registry, bound to a specific deployed contract.
+func NewRegistryTransactor(address common.Address, transactor bind.ContractTransactor) (*RegistryTransactor, error) {
+	contract, err := bindRegistry(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &RegistryTransactor{contract: contract}, nil
+}
+
+// NewRegistryFilterer creates a new log filterer instance of Registry, bound to a specific deployed contract.
+func NewRegistryFilterer(address common.Address, filterer bind.ContractFilterer) (*RegistryFilterer, error) {
+	contract, err := bindRegistry(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &RegistryFilterer{contract: contract}, nil
+}
+
+// bindRegistry binds a generic wrapper to an already deployed contract.
+func bindRegistry(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := abi.JSON(strings.NewReader(RegistryABI))
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_Registry *RegistryRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Registry.Contract.RegistryCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_Registry *RegistryRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Registry.Contract.RegistryTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_Registry *RegistryRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Registry.Contract.RegistryTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_Registry *RegistryCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Registry.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_Registry *RegistryTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Registry.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_Registry *RegistryTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Registry.Contract.contract.Transact(opts, method, params...)
+}
+
+// Decimals is a free data retrieval call binding the contract method 0x58e2d3a8.
+//
+// Solidity: function decimals(address base, address quote) view returns(uint8)
+func (_Registry *RegistryCaller) Decimals(opts *bind.CallOpts, base common.Address, quote common.Address) (uint8, error) {
+	var out []interface{}
+	err := _Registry.contract.Call(opts, &out, "decimals", base, quote)
+
+	if err != nil {
+		return *new(uint8), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint8)).(*uint8)
+
+	return out0, err
+
+}
+
+// Decimals is a free data retrieval call binding the contract method 0x58e2d3a8.
+//
+// Solidity: function decimals(address base, address quote) view returns(uint8)
+func (_Registry *RegistrySession) Decimals(base common.Address, quote common.Address) (uint8, error) {
+	return _Registry.Contract.Decimals(&_Registry.CallOpts, base, quote)
+}
+
+// Decimals is a free data retrieval call binding the contract method 0x58e2d3a8.
+//
+// Solidity: function decimals(address base, address quote) view returns(uint8)
+func (_Registry *RegistryCallerSession) Decimals(base common.Address, quote common.Address) (uint8, error) {
+	return _Registry.Contract.Decimals(&_Registry.CallOpts, base, quote)
+}
+
+// Description is a free data retrieval call binding the contract method 0xfa820de9.
+//
+// Solidity: function description(address base, address quote) view returns(string)
+func (_Registry *RegistryCaller) Description(opts *bind.CallOpts, base common.Address, quote common.Address) (string, error) {
+	var out []interface{}
+	err := _Registry.contract.Call(opts, &out, "description", base, quote)
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// Description is a free data retrieval call binding the contract method 0xfa820de9.
+//
+// Solidity: function description(address base, address quote) view returns(string)
+func (_Registry *RegistrySession) Description(base common.Address, quote common.Address) (string, error) {
+	return _Registry.Contract.Description(&_Registry.CallOpts, base, quote)
+}
+
+// Description is a free data retrieval call binding the contract method 0xfa820de9.
+//
+// Solidity: function description(address base, address quote) view returns(string)
+func (_Registry *RegistryCallerSession) Description(base common.Address, quote common.Address) (string, error) {
+	return _Registry.Contract.Description(&_Registry.CallOpts, base, quote)
+}
+
+// GetCurrentPhaseId is a free data retrieval call binding the contract method 0x30322818.
+//
+// Solidity: function getCurrentPhaseId(address base, address quote) view returns(uint16 currentPhaseId)
+func (_Registry *RegistryCaller) GetCurrentPhaseId(opts *bind.CallOpts, base common.Address, quote common.Address) (uint16, error) {
+	var out []interface{}
+	err := _Registry.contract.Call(opts, &out, "getCurrentPhaseId", base, quote)
+
+	if err != nil {
+		return *new(uint16), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint16)).(*uint16)
+
+	return out0, err
+
+}
+
+// GetCurrentPhaseId is a free data retrieval call binding the contract method 0x30322818.
+//
+// Solidity: function getCurrentPhaseId(address base, address quote) view returns(uint16 currentPhaseId)
+func (_Registry *RegistrySession) GetCurrentPhaseId(base common.Address, quote common.Address) (uint16, error) {
+	return _Registry.Contract.GetCurrentPhaseId(&_Registry.CallOpts, base, quote)
+}
+
+// GetCurrentPhaseId is a free data retrieval call binding the contract method 0x30322818.
+//
+// Solidity: function getCurrentPhaseId(address base, address quote) view returns(uint16 currentPhaseId)
+func (_Registry *RegistryCallerSession) GetCurrentPhaseId(base common.Address, quote common.Address) (uint16, error) {
+	return _Registry.Contract.GetCurrentPhaseId(&_Registry.CallOpts, base, quote)
+}
+
+// GetFeed is a free data retrieval call binding the contract method 0xd2edb6dd.
+//
+// Solidity: function getFeed(address base, address quote) view returns(address aggregator)
+func (_Registry *RegistryCaller) GetFeed(opts *bind.CallOpts, base common.Address, quote common.Address) (common.Address, error) {
+	var out []interface{}
+	err := _Registry.contract.Call(opts, &out, "getFeed", base, quote)
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// GetFeed is a free data retrieval call binding the contract method 0xd2edb6dd.
+//
+// Solidity: function getFeed(address base, address quote) view returns(address aggregator)
+func (_Registry *RegistrySession) GetFeed(base common.Address, quote common.Address) (common.Address, error) {
+	return _Registry.Contract.GetFeed(&_Registry.CallOpts, base, quote)
+}
+
+// GetFeed is a free data retrieval call binding the contract method 0xd2edb6dd.
+//
+// Solidity: function getFeed(address base, address quote) view returns(address aggregator)
+func (_Registry *RegistryCallerSession) GetFeed(base common.Address, quote common.Address) (common.Address, error) {
+	return _Registry.Contract.GetFeed(&_Registry.CallOpts, base, quote)
+}
+
+// GetPhaseFeed is a free data retrieval call binding the contract method 0x52dbeb8b.
+//
+// Solidity: function getPhaseFeed(address base, address quote, uint16 phaseId) view returns(address aggregator)
+func (_Registry *RegistryCaller) GetPhaseFeed(opts *bind.CallOpts, base common.Address, quote common.Address, phaseId uint16) (common.Address, error) {
+	var out []interface{}
+	err := _Registry.contract.Call(opts, &out, "getPhaseFeed", base, quote, phaseId)
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// GetPhaseFeed is a free data retrieval call binding the contract method 0x52dbeb8b.
+//
+// Solidity: function getPhaseFeed(address base, address quote, uint16 phaseId) view returns(address aggregator)
+func (_Registry *RegistrySession) GetPhaseFeed(base common.Address, quote common.Address, phaseId uint16) (common.Address, error) {
+	return _Registry.Contract.GetPhaseFeed(&_Registry.CallOpts, base, quote, phaseId)
+}
+
+// GetPhaseFeed is a free data retrieval call binding the contract method 0x52dbeb8b.
+//
+// Solidity: function getPhaseFeed(address base, address quote, uint16 phaseId) view returns(address aggregator)
+func (_Registry *RegistryCallerSession) GetPhaseFeed(base common.Address, quote common.Address, phaseId uint16) (common.Address, error) {
+	return _Registry.Contract.GetPhaseFeed(&_Registry.CallOpts, base, quote, phaseId)
+}
+
+// GetProposedFeed is a free data retrieval call binding the contract method 0x5ad9d9df.
+//
+// Solidity: function getProposedFeed(address base, address quote) view returns(address proposedAggregator)
+func (_Registry *RegistryCaller) GetProposedFeed(opts *bind.CallOpts, base common.Address, quote common.Address) (common.Address, error) {
+	var out []interface{}
+	err := _Registry.contract.Call(opts, &out, "getProposedFeed", base, quote)
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// GetProposedFeed is a free data retrieval call binding the contract method 0x5ad9d9df.
+//
+// Solidity: function getProposedFeed(address base, address quote) view returns(address proposedAggregator)
+func (_Registry *RegistrySession) GetProposedFeed(base common.Address, quote common.Address) (common.Address, error) {
+	return _Registry.Contract.GetProposedFeed(&_Registry.CallOpts, base, quote)
+}
+
+// GetProposedFeed is a free data retrieval call binding the contract method 0x5ad9d9df.
+//
+// Solidity: function getProposedFeed(address base, address quote) view returns(address proposedAggregator)
+func (_Registry *RegistryCallerSession) GetProposedFeed(base common.Address, quote common.Address) (common.Address, error) {
+	return _Registry.Contract.GetProposedFeed(&_Registry.CallOpts, base, quote)
+}
+
+// GetRoundData is a free data retrieval call binding the contract method 0xfc58749e.
+//
+// Solidity: function getRoundData(address base, address quote, uint80 _roundId) view returns(uint80 roundId, int256 answer, uint256 startedAt, uint256 updatedAt, uint80 answeredInRound)
+func (_Registry *RegistryCaller) GetRoundData(opts *bind.CallOpts, base common.Address, quote common.Address, _roundId *big.Int) (struct {
+	RoundId         *big.Int
+	Answer          *big.Int
+	StartedAt       *big.Int
+	UpdatedAt       *big.Int
+	AnsweredInRound *big.Int
+}, error) {
+	var out []interface{}
+	err := _Registry.contract.Call(opts, &out, "getRoundData", base, quote, _roundId)
+
+	outstruct := new(struct {
+		RoundId         *big.Int
+		Answer          *big.Int
+		StartedAt       *big.Int
+		UpdatedAt       *big.Int
+		AnsweredInRound *big.Int
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.RoundId = *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+	outstruct.Answer = *abi.ConvertType(out[1], new(*big.Int)).(**big.Int)
+	outstruct.StartedAt = *abi.ConvertType(out[2], new(*big.Int)).(**big.Int)
+	outstruct.UpdatedAt = *abi.ConvertType(out[3], new(*big.Int)).(**big.Int)
+	outstruct.AnsweredInRound = *abi.ConvertType(out[4], new(*big.Int)).(**big.Int)
+
+	return *outstruct, err
+
+}
+
+// GetRoundData is a free data retrieval call binding the contract method 0xfc58749e.
+//
+// Solidity: function getRoundData(address base, address quote, uint80 _roundId) view returns(uint80 roundId, int256 answer, uint256 startedAt, uint256 updatedAt, uint80 answeredInRound)
+func (_Registry *RegistrySession) GetRoundData(base common.Address, quote common.Address, _roundId *big.Int) (struct {
+	RoundId         *big.Int
+	Answer          *big.Int
+	StartedAt       *big.Int
+	UpdatedAt       *big.Int
+	AnsweredInRound *big.Int
+}, error) {
+	return _Registry.Contract.GetRoundData(&_Registry.CallOpts, base, quote, _roundId)
+}
+
+// GetRoundData is a free data retrieval call binding the contract method 0xfc58749e.
+//
+// Solidity: function getRoundData(address base, address quote, uint80 _roundId) view returns(uint80 roundId, int256 answer, uint256 startedAt, uint256 updatedAt, uint80 answeredInRound)
+func (_Registry *RegistryCallerSession) GetRoundData(base common.Address, quote common.Address, _roundId *big.Int) (struct {
+	RoundId         *big.Int
+	Answer          *big.Int
+	StartedAt       *big.Int
+	UpdatedAt       *big.Int
+	AnsweredInRound *big.Int
+}, error) {
+	return _Registry.Contract.GetRoundData(&_Registry.CallOpts, base, quote, _roundId)
+}
+
+// IsFeedEnabled is a free data retrieval call binding the contract method 0xb099d43b.
+//
+// Solidity: function isFeedEnabled(address aggregator) view returns(bool)
+func (_Registry *RegistryCaller) IsFeedEnabled(opts *bind.CallOpts, aggregator common.Address) (bool, error) {
+	var out []interface{}
+	err := _Registry.contract.Call(opts, &out, "isFeedEnabled", aggregator)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// IsFeedEnabled is a free data retrieval call binding the contract method 0xb099d43b.
+//
+// Solidity: function isFeedEnabled(address aggregator) view returns(bool)
+func (_Registry *RegistrySession) IsFeedEnabled(aggregator common.Address) (bool, error) {
+	return _Registry.Contract.IsFeedEnabled(&_Registry.CallOpts, aggregator)
+}
+
+// IsFeedEnabled is a free data retrieval call binding the contract method 0xb099d43b.
+//
+// Solidity: function isFeedEnabled(address aggregator) view returns(bool)
+func (_Registry *RegistryCallerSession) IsFeedEnabled(aggregator common.Address) (bool, error) {
+	return _Registry.Contract.IsFeedEnabled(&_Registry.CallOpts, aggregator)
+}
+
+// LatestRoundData is a free data retrieval call binding the contract method 0xbcfd032d.
+//
+// Solidity: function latestRoundData(address base, address quote) view returns(uint80 roundId, int256 answer, uint256 startedAt, uint256 updatedAt, uint80 answeredInRound)
+func (_Registry *RegistryCaller) LatestRoundData(opts *bind.CallOpts, base common.Address, quote common.Address) (struct {
+	RoundId         *big.Int
+	Answer          *big.Int
+	StartedAt       *big.Int
+	UpdatedAt       *big.Int
+	AnsweredInRound *big.Int
+}, error) {
+	var out []interface{}
+	err := _Registry.contract.Call(opts, &out, "latestRoundData", base, quote)
+
+	outstruct := new(struct {
+		RoundId         *big.Int
+		Answer          *big.Int
+		StartedAt       *big.Int
+		UpdatedAt       *big.Int
+		AnsweredInRound *big.Int
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.RoundId = *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+	outstruct.Answer = *abi.ConvertType(out[1], new(*big.Int)).(**big.Int)
+	outstruct.StartedAt = *abi.ConvertType(out[2], new(*big.Int)).(**big.Int)
+	outstruct.UpdatedAt = *abi.ConvertType(out[3], new(*big.Int)).(**big.Int)
+	outstruct.AnsweredInRound = *abi.ConvertType(out[4], new(*big.Int)).(**big.Int)
+
+	return *outstruct, err
+
+}
+
+// LatestRoundData is a free data retrieval call binding the contract method 0xbcfd032d.
+//
+// Solidity: function latestRoundData(address base, address quote) view returns(uint80 roundId, int256 answer, uint256 startedAt, uint256 updatedAt, uint80 answeredInRound)
+func (_Registry *RegistrySession) LatestRoundData(base common.Address, quote common.Address) (struct {
+	RoundId         *big.Int
+	Answer          *big.Int
+	StartedAt       *big.Int
+	UpdatedAt       *big.Int
+	AnsweredInRound *big.Int
+}, error) {
+	return _Registry.Contract.LatestRoundData(&_Registry.CallOpts, base, quote)
+}
+
+// LatestRoundData is a free data retrieval call binding the contract method 0xbcfd032d.
+//
+// Solidity: function latestRoundData(address base, address quote) view returns(uint80 roundId, int256 answer, uint256 startedAt, uint256 updatedAt, uint80 answeredInRound)
+func (_Registry *RegistryCallerSession) LatestRoundData(base common.Address, quote common.Address) (struct {
+	RoundId         *big.Int
+	Answer          *big.Int
+	StartedAt       *big.Int
+	UpdatedAt       *big.Int
+	AnsweredInRound *big.Int
+}, error) {
+	return _Registry.Contract.LatestRoundData(&_Registry.CallOpts, base, quote)
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_Registry *RegistryCaller) Owner(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _Registry.contract.Call(opts, &out, "owner")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_Registry *RegistrySession) Owner() (common.Address, error) {
+	return _Registry.Contract.Owner(&_Registry.CallOpts)
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_Registry *RegistryCallerSession) Owner() (common.Address, error) {
+	return _Registry.Contract.Owner(&_Registry.CallOpts)
+}
+
+// ProposedGetRoundData is a free data retrieval call binding the contract method 0x8916524a.
+//
+// Solidity: function proposedGetRoundData(address base, address quote, uint80 roundId) view returns(uint80 roundId, int256 answer, uint256 startedAt, uint256 updatedAt, uint80 answeredInRound)
+func (_Registry *RegistryCaller) ProposedGetRoundData(opts *bind.CallOpts, base common.Address, quote common.Address, roundId *big.Int) (struct {
+	RoundId         *big.Int
+	Answer          *big.Int
+	StartedAt       *big.Int
+	UpdatedAt       *big.Int
+	AnsweredInRound *big.Int
+}, error) {
+	var out []interface{}
+	err := _Registry.contract.Call(opts, &out, "proposedGetRoundData", base, quote, roundId)
+
+	outstruct := new(struct {
+		RoundId         *big.Int
+		Answer          *big.Int
+		StartedAt       *big.Int
+		UpdatedAt       *big.Int
+		AnsweredInRound *big.Int
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.RoundId = *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+	outstruct.Answer = *abi.ConvertType(out[1], new(*big.Int)).(**big.Int)
+	outstruct.StartedAt = *abi.ConvertType(out[2], new(*big.Int)).(**big.Int)
+	outstruct.UpdatedAt = *abi.ConvertType(out[3], new(*big.Int)).(**big.Int)
+	outstruct.AnsweredInRound = *abi.ConvertType(out[4], new(*big.Int)).(**big.Int)
+
+	return *outstruct, err
+
+}
+
+// ProposedGetRoundData is a free data retrieval call binding the contract method 0x8916524a.
+//
+// Solidity: function proposedGetRoundData(address base, address quote, uint80 roundId) view returns(uint80 roundId, int256 answer, uint256 startedAt, uint256 updatedAt, uint80 answeredInRound)
+func (_Registry *RegistrySession) ProposedGetRoundData(base common.Address, quote common.Address, roundId *big.Int) (struct {
+	RoundId         *big.Int
+	Answer          *big.Int
+	StartedAt       *big.Int
+	UpdatedAt       *big.Int
+	AnsweredInRound *big.Int
+}, error) {
+	return _Registry.Contract.ProposedGetRoundData(&_Registry.CallOpts, base, quote, roundId)
+}
+
+// ProposedGetRoundData is a free data retrieval call binding the contract method 0x8916524a.
+//
+// Solidity: function proposedGetRoundData(address base, address quote, uint80 roundId) view returns(uint80 roundId, int256 answer, uint256 startedAt, uint256 updatedAt, uint80 answeredInRound)
+func (_Registry *RegistryCallerSession) ProposedGetRoundData(base common.Address, quote common.Address, roundId *big.Int) (struct {
+	RoundId         *big.Int
+	Answer          *big.Int
+	StartedAt       *big.Int
+	UpdatedAt       *big.Int
+	AnsweredInRound *big.Int
+}, error) {
+	return _Registry.Contract.ProposedGetRoundData(&_Registry.CallOpts, base, quote, roundId)
+}
+
+// ProposedLatestRoundData is a free data retrieval call binding the contract method 0xd0188fc6.
+//
+// Solidity: function proposedLatestRoundData(address base, address quote) view returns(uint80 roundId, int256 answer, uint256 startedAt, uint256 updatedAt, uint80 answeredInRound)
+func (_Registry *RegistryCaller) ProposedLatestRoundData(opts *bind.CallOpts, base common.Address, quote common.Address) (struct {
+	RoundId         *big.Int
+	Answer          *big.Int
+	StartedAt       *big.Int
+	UpdatedAt       *big.Int
+	AnsweredInRound *big.Int
+}, error) {
+	var out []interface{}
+	err := _Registry.contract.Call(opts, &out, "proposedLatestRoundData", base, quote)
+
+	outstruct := new(struct {
+		RoundId         *big.Int
+		Answer          *big.Int
+		StartedAt       *big.Int
+		UpdatedAt       *big.Int
+		AnsweredInRound *big.Int
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.RoundId = *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+	outstruct.Answer = *abi.ConvertType(out[1], new(*big.Int)).(**big.Int)
+	outstruct.StartedAt = *abi.ConvertType(out[2], new(*big.Int)).(**big.Int)
+	outstruct.UpdatedAt = *abi.ConvertType(out[3], new(*big.Int)).(**big.Int)
+	outstruct.AnsweredInRound = *abi.ConvertType(out[4], new(*big.Int)).(**big.Int)
+
+	return *outstruct, err
+
+}
+
+// ProposedLatestRoundData is a free data retrieval call binding the contract method 0xd0188fc6.
+//
+// Solidity: function proposedLatestRoundData(address base, address quote) view returns(uint80 roundId, int256 answer, uint256 startedAt, uint256 updatedAt, uint80 answeredInRound)
+func (_Registry *RegistrySession) ProposedLatestRoundData(base common.Address, quote common.Address) (struct {
+	RoundId         *big.Int
+	Answer          *big.Int
+	StartedAt       *big.Int
+	UpdatedAt       *big.Int
+	AnsweredInRound *big.Int
+}, error) {
+	return _Registry.Contract.ProposedLatestRoundData(&_Registry.CallOpts, base, quote)
+}
+
+// ProposedLatestRoundData is a free data retrieval call binding the contract method 0xd0188fc6.
+//
+// Solidity: function proposedLatestRoundData(address base, address quote) view returns(uint80 roundId, int256 answer, uint256 startedAt, uint256 updatedAt, uint80 answeredInRound)
+func (_Registry *RegistryCallerSession) ProposedLatestRoundData(base common.Address, quote common.Address) (struct {
+	RoundId         *big.Int
+	Answer          *big.Int
+	StartedAt       *big.Int
+	UpdatedAt       *big.Int
+	AnsweredInRound *big.Int
+}, error) {
+	return _Registry.Contract.ProposedLatestRoundData(&_Registry.CallOpts, base, quote)
+}
+
+// Version is a free data retrieval call binding the contract method 0xaf34b03a.
+//
+// Solidity: function version(address base, address quote) view returns(uint256)
+func (_Registry *RegistryCaller) Version(opts *bind.CallOpts, base common.Address, quote common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _Registry.contract.Call(opts, &out, "version", base, quote)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// Version is a free data retrieval call binding the contract method 0xaf34b03a.
+//
+// Solidity: function version(address base, address quote) view returns(uint256)
+func (_Registry *RegistrySession) Version(base common.Address, quote common.Address) (*big.Int, error) {
+	return _Registry.Contract.Version(&_Registry.CallOpts, base, quote)
+}
+
+// Version is a free data retrieval call binding the contract method 0xaf34b03a.
+//
+// Solidity: function version(address base, address quote) view returns(uint256)
+func (_Registry *RegistryCallerSession) Version(base common.Address, quote common.Address) (*big.Int, error) {
+	return _Registry.Contract.Version(&_Registry.CallOpts, base, quote)
+}
+
+// AcceptOwnership is a paid mutator transaction binding the contract method 0x79ba5097.
+//
+// Solidity: function acceptOwnership() returns()
+func (_Registry *RegistryTransactor) AcceptOwnership(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Registry.contract.Transact(opts, "acceptOwnership")
+}
+
+// AcceptOwnership is a paid mutator transaction binding the contract method 0x79ba5097.
+//
+// Solidity: function acceptOwnership() returns()
+func (_Registry *RegistrySession) AcceptOwnership() (*types.Transaction, error) {
+	return _Registry.Contract.AcceptOwnership(&_Registry.TransactOpts)
+}
+
+// AcceptOwnership is a paid mutator transaction binding the contract method 0x79ba5097.
+//
+// Solidity: function acceptOwnership() returns()
+func (_Registry *RegistryTransactorSession) AcceptOwnership() (*types.Transaction, error) {
+	return _Registry.Contract.AcceptOwnership(&_Registry.TransactOpts)
+}
+
+// ConfirmFeed is a paid mutator transaction binding the contract method 0x045abf4b.
+//
+// Solidity: function confirmFeed(address base, address quote, address aggregator) returns()
+func (_Registry *RegistryTransactor) ConfirmFeed(opts *bind.TransactOpts, base common.Address, quote common.Address, aggregator common.Address) (*types.Transaction, error) {
+	return _Registry.contract.Transact(opts, "confirmFeed", base, quote, aggregator)
+}
+
+// ConfirmFeed is a paid mutator transaction binding the contract method 0x045abf4b.
+//
+// Solidity: function confirmFeed(address base, address quote, address aggregator) returns()
+func (_Registry *RegistrySession) ConfirmFeed(base common.Address, quote common.Address, aggregator common.Address) (*types.Transaction, error) {
+	return _Registry.Contract.ConfirmFeed(&_Registry.TransactOpts, base, quote, aggregator)
+}
+
+// ConfirmFeed is a paid mutator transaction binding the contract method 0x045abf4b.
+//
+// Solidity: function confirmFeed(address base, address quote, address aggregator) returns()
+func (_Registry *RegistryTransactorSession) ConfirmFeed(base common.Address, quote common.Address, aggregator common.Address) (*types.Transaction, error) {
+	return _Registry.Contract.ConfirmFeed(&_Registry.TransactOpts, base, quote, aggregator)
+}
+
+// ProposeFeed is a paid mutator transaction binding the contract method 0x9eed82b0.
+//
+// Solidity: function proposeFeed(address base, address quote, address aggregator) returns()
+func (_Registry *RegistryTransactor) ProposeFeed(opts *bind.TransactOpts, base common.Address, quote common.Address, aggregator common.Address) (*types.Transaction, error) {
+	return _Registry.contract.Transact(opts, "proposeFeed", base, quote, aggregator)
+}
+
+// ProposeFeed is a paid mutator transaction binding the contract method 0x9eed82b0.
+//
+// Solidity: function proposeFeed(address base, address quote, address aggregator) returns()
+func (_Registry *RegistrySession) ProposeFeed(base common.Address, quote common.Address, aggregator common.Address) (*types.Transaction, error) {
+	return _Registry.Contract.ProposeFeed(&_Registry.TransactOpts, base, quote, aggregator)
+}
+
+// ProposeFeed is a paid mutator transaction binding the contract method 0x9eed82b0.
+//
+// Solidity: function proposeFeed(address base, address quote, address aggregator) returns()
+func (_Registry *RegistryTransactorSession) ProposeFeed(base common.Address, quote common.Address, aggregator common.Address) (*types.Transaction, error) {
+	return _Registry.Contract.ProposeFeed(&_Registry.TransactOpts, base, quote, aggregator)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address to) returns()
+func (_Registry *RegistryTransactor) TransferOwnership(opts *bind.TransactOpts, to common.Address) (*types.Transaction, error) {
+	return _Registry.contract.Transact(opts, "transferOwnership", to)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address to) returns()
+func (_Registry *RegistrySession) TransferOwnership(to common.Address) (*types.Transaction, error) {
+	return _Registry.Contract.TransferOwnership(&_Registry.TransactOpts, to)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address to) returns()
+func (_Registry *RegistryTransactorSession) TransferOwnership(to common.Address) (*types.Transaction, error) {
+	return _Registry.Contract.TransferOwnership(&_Registry.TransactOpts, to)
+}
+
+// RegistryFeedConfirmedIterator is returned from FilterFeedConfirmed and is used to iterate over the raw logs and unpacked data for FeedConfirmed events raised by the Registry contract.
+type RegistryFeedConfirmedIterator struct {
+	Event *RegistryFeedConfirmed // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *RegistryFeedConfirmedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(RegistryFeedConfirmed)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(RegistryFeedConfirmed)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *RegistryFeedConfirmedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *RegistryFeedConfirmedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// RegistryFeedConfirmed represents a FeedConfirmed event raised by the Registry contract.
+type RegistryFeedConfirmed struct {
+	Asset              common.Address
+	Denomination       common.Address
+	LatestAggregator   common.Address
+	PreviousAggregator common.Address
+	NextPhaseId        uint16
+	Sender             common.Address
+	Raw                types.Log // Blockchain specific contextual infos
+}
+
+// FilterFeedConfirmed is a free log retrieval operation binding the contract event 0x27a180c70f2642f63d1694eb252b7df52e7ab2565e3f67adf7748acb7d82b9bc.
+//
+// Solidity: event FeedConfirmed(address indexed asset, address indexed denomination, address indexed latestAggregator, address previousAggregator, uint16 nextPhaseId, address sender)
+func (_Registry *RegistryFilterer) FilterFeedConfirmed(opts *bind.FilterOpts, asset []common.Address, denomination []common.Address, latestAggregator []common.Address) (*RegistryFeedConfirmedIterator, error) {
+
+	var assetRule []interface{}
+	for _, assetItem := range asset {
+		assetRule = append(assetRule, assetItem)
+	}
+	var denominationRule []interface{}
+	for _, denominationItem := range denomination {
+		denominationRule = append(denominationRule, denominationItem)
+	}
+	var latestAggregatorRule []interface{}
+	for _, latestAggregatorItem := range latestAggregator {
+		latestAggregatorRule = append(latestAggregatorRule, latestAggregatorItem)
+	}
+
+	logs, sub, err := _Registry.contract.FilterLogs(opts, "FeedConfirmed", assetRule, denominationRule, latestAggregatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return &RegistryFeedConfirmedIterator{contract: _Registry.contract, event: "FeedConfirmed", logs: logs, sub: sub}, nil
+}
+
+// WatchFeedConfirmed is a free log subscription operation binding the contract event 0x27a180c70f2642f63d1694eb252b7df52e7ab2565e3f67adf7748acb7d82b9bc.
+//
+// Solidity: event FeedConfirmed(address indexed asset, address indexed denomination, address indexed latestAggregator, address previousAggregator, uint16 nextPhaseId, address sender)
+func (_Registry *RegistryFilterer) WatchFeedConfirmed(opts *bind.WatchOpts, sink chan<- *RegistryFeedConfirmed, asset []common.Address, denomination []common.Address, latestAggregator []common.Address) (event.Subscription, error) {
+
+	var assetRule []interface{}
+	for _, assetItem := range asset {
+		assetRule = append(assetRule, assetItem)
+	}
+	var denominationRule []interface{}
+	for _, denominationItem := range denomination {
+		denominationRule = append(denominationRule, denominationItem)
+	}
+	var latestAggregatorRule []interface{}
+	for _, latestAggregatorItem := range latestAggregator {
+		latestAggregatorRule = append(latestAggregatorRule, latestAggregatorItem)
+	}
+
+	logs, sub, err := _Registry.contract.WatchLogs(opts, "FeedConfirmed", assetRule, denominationRule, latestAggregatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(RegistryFeedConfirmed)
+				if err := _Registry.contract.UnpackLog(event, "FeedConfirmed", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseFeedConfirmed is a log parse operation binding the contract event 0x27a180c70f2642f63d1694eb252b7df52e7ab2565e3f67adf7748acb7d82b9bc.
+//
+// Solidity: event FeedConfirmed(address indexed asset, address indexed denomination, address indexed latestAggregator, address previousAggregator, uint16 nextPhaseId, address sender)
+func (_Registry *RegistryFilterer) ParseFeedConfirmed(log types.Log) (*RegistryFeedConfirmed, error) {
+	event := new(RegistryFeedConfirmed)
+	if err := _Registry.contract.UnpackLog(event, "FeedConfirmed", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// RegistryFeedProposedIterator is returned from FilterFeedProposed and is used to iterate over the raw logs and unpacked data for FeedProposed events raised by the Registry contract.
+type RegistryFeedProposedIterator struct {
+	Event *RegistryFeedProposed // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *RegistryFeedProposedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(RegistryFeedProposed)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(RegistryFeedProposed)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *RegistryFeedProposedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *RegistryFeedProposedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// RegistryFeedProposed represents a FeedProposed event raised by the Registry contract.
+type RegistryFeedProposed struct {
+	Asset              common.Address
+	Denomination       common.Address
+	ProposedAggregator common.Address
+	CurrentAggregator  common.Address
+	Sender             common.Address
+	Raw                types.Log // Blockchain specific contextual infos
+}
+
+// FilterFeedProposed is a free log retrieval operation binding the contract event 0xb56c4f88c3e344891ef92e51f036d7116e886f4ea57f5ba93e28b5f44925b9ce.
+//
+// Solidity: event FeedProposed(address indexed asset, address indexed denomination, address indexed proposedAggregator, address currentAggregator, address sender)
+func (_Registry *RegistryFilterer) FilterFeedProposed(opts *bind.FilterOpts, asset []common.Address, denomination []common.Address, proposedAggregator []common.Address) (*RegistryFeedProposedIterator, error) {
+
+	var assetRule []interface{}
+	for _, assetItem := range asset {
+		assetRule = append(assetRule, assetItem)
+	}
+	var denominationRule []interface{}
+	for _, denominationItem := range denomination {
+		denominationRule = append(denominationRule, denominationItem)
+	}
+	var proposedAggregatorRule []interface{}
+	for _, proposedAggregatorItem := range proposedAggregator {
+		proposedAggregatorRule = append(proposedAggregatorRule, proposedAggregatorItem)
+	}
+
+	logs, sub, err := _Registry.contract.FilterLogs(opts, "FeedProposed", assetRule, denominationRule, proposedAggregatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return &RegistryFeedProposedIterator{contract: _Registry.contract, event: "FeedProposed", logs: logs, sub: sub}, nil
+}
+
+// WatchFeedProposed is a free log subscription operation binding the contract event 0xb56c4f88c3e344891ef92e51f036d7116e886f4ea57f5ba93e28b5f44925b9ce.
+//
+// Solidity: event FeedProposed(address indexed asset, address indexed denomination, address indexed proposedAggregator, address currentAggregator, address sender)
+func (_Registry *RegistryFilterer) WatchFeedProposed(opts *bind.WatchOpts, sink chan<- *RegistryFeedProposed, asset []common.Address, denomination []common.Address, proposedAggregator []common.Address) (event.Subscription, error) {
+
+	var assetRule []interface{}
+	for _, assetItem := range asset {
+		assetRule = append(assetRule, assetItem)
+	}
+	var denominationRule []interface{}
+	for _, denominationItem := range denomination {
+		denominationRule = append(denominationRule, denominationItem)
+	}
+	var proposedAggregatorRule []interface{}
+	for _, proposedAggregatorItem := range proposedAggregator {
+		proposedAggregatorRule = append(proposedAggregatorRule, proposedAggregatorItem)
+	}
+
+	logs, sub, err := _Registry.contract.WatchLogs(opts, "FeedProposed", assetRule, denominationRule, proposedAggregatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(RegistryFeedProposed)
+				if err := _Registry.contract.UnpackLog(event, "FeedProposed", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseFeedProposed is a log parse operation binding the contract event 0xb56c4f88c3e344891ef92e51f036d7116e886f4ea57f5ba93e28b5f44925b9ce.
+//
+// Solidity: event FeedProposed(address indexed asset, address indexed denomination, address indexed proposedAggregator, address currentAggregator, address sender)
+func (_Registry *RegistryFilterer) ParseFeedProposed(log types.Log) (*RegistryFeedProposed, error) {
+	event := new(RegistryFeedProposed)
+	if err := _Registry.contract.UnpackLog(event, "FeedProposed", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// RegistryOwnershipTransferRequestedIterator is returned from FilterOwnershipTransferRequested and is used to iterate over the raw logs and unpacked data for OwnershipTransferRequested events raised by the Registry contract.
+type RegistryOwnershipTransferRequestedIterator struct {
+	Event *RegistryOwnershipTransferRequested // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *RegistryOwnershipTransferRequestedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(RegistryOwnershipTransferRequested)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(RegistryOwnershipTransferRequested)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *RegistryOwnershipTransferRequestedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *RegistryOwnershipTransferRequestedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// RegistryOwnershipTransferRequested represents a OwnershipTransferRequested event raised by the Registry contract.
+type RegistryOwnershipTransferRequested struct {
+	From common.Address
+	To   common.Address
+	Raw  types.Log // Blockchain specific contextual infos
+}
+
+// FilterOwnershipTransferRequested is a free log retrieval operation binding the contract event 0xed8889f560326eb138920d842192f0eb3dd22b4f139c87a2c57538e05bae1278.
+//
+// Solidity: event OwnershipTransferRequested(address indexed from, address indexed to)
+func (_Registry *RegistryFilterer) FilterOwnershipTransferRequested(opts *bind.FilterOpts, from []common.Address, to []common.Address) (*RegistryOwnershipTransferRequestedIterator, error) {
+
+	var fromRule []interface{}
+	for _, fromItem := range from {
+		fromRule = append(fromRule, fromItem)
+	}
+	var toRule []interface{}
+	for _, toItem := range to {
+		toRule = append(toRule, toItem)
+	}
+
+	logs, sub, err := _Registry.contract.FilterLogs(opts, "OwnershipTransferRequested", fromRule, toRule)
+	if err != nil {
+		return nil, err
+	}
+	return &RegistryOwnershipTransferRequestedIterator{contract: _Registry.contract, event: "OwnershipTransferRequested", logs: logs, sub: sub}, nil
+}
+
+// WatchOwnershipTransferRequested is a free log subscription operation binding the contract event 0xed8889f560326eb138920d842192f0eb3dd22b4f139c87a2c57538e05bae1278.
+//
+// Solidity: event OwnershipTransferRequested(address indexed from, address indexed to)
+func (_Registry *RegistryFilterer) WatchOwnershipTransferRequested(opts *bind.WatchOpts, sink chan<- *RegistryOwnershipTransferRequested, from []common.Address, to []common.Address) (event.Subscription, error) {
+
+	var fromRule []interface{}
+	for _, fromItem := range from {
+		fromRule = append(fromRule, fromItem)
+	}
+	var toRule []interface{}
+	for _, toItem := range to {
+		toRule = append(toRule, toItem)
+	}
+
+	logs, sub, err := _Registry.contract.WatchLogs(opts, "OwnershipTransferRequested", fromRule, toRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(RegistryOwnershipTransferRequested)
+				if err := _Registry.contract.UnpackLog(event, "OwnershipTransferRequested", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseOwnershipTransferRequested is a log parse operation binding the contract event 0xed8889f560326eb138920d842192f0eb3dd22b4f139c87a2c57538e05bae1278.
+//
+// Solidity: event OwnershipTransferRequested(address indexed from, address indexed to)
+func (_Registry *RegistryFilterer) ParseOwnershipTransferRequested(log types.Log) (*RegistryOwnershipTransferRequested, error) {
+	event := new(RegistryOwnershipTransferRequested)
+	if err := _Registry.contract.UnpackLog(event, "OwnershipTransferRequested", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// RegistryOwnershipTransferredIterator is returned from FilterOwnershipTransferred and is used to iterate over the raw logs and unpacked data for OwnershipTransferred events raised by the Registry contract.
+type RegistryOwnershipTransferredIterator struct {
+	Event *RegistryOwnershipTransferred // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *RegistryOwnershipTransferredIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(RegistryOwnershipTransferred)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(RegistryOwnershipTransferred)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *RegistryOwnershipTransferredIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *RegistryOwnershipTransferredIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// RegistryOwnershipTransferred represents a OwnershipTransferred event raised by the Registry contract.
+type RegistryOwnershipTransferred struct {
+	From common.Address
+	To   common.Address
+	Raw  types.Log // Blockchain specific contextual infos
+}
+
+// FilterOwnershipTransferred is a free log retrieval operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed from, address indexed to)
+func (_Registry *RegistryFilterer) FilterOwnershipTransferred(opts *bind.FilterOpts, from []common.Address, to []common.Address) (*RegistryOwnershipTransferredIterator, error) {
+
+	var fromRule []interface{}
+	for _, fromItem := range from {
+		fromRule = append(fromRule, fromItem)
+	}
+	var toRule []interface{}
+	for _, toItem := range to {
+		toRule = append(toRule, toItem)
+	}
+
+	logs, sub, err := _Registry.contract.FilterLogs(opts, "OwnershipTransferred", fromRule, toRule)
+	if err != nil {
+		return nil, err
+	}
+	return &RegistryOwnershipTransferredIterator{contract: _Registry.contract, event: "OwnershipTransferred", logs: logs, sub: sub}, nil
+}
+
+// WatchOwnershipTransferred is a free log subscription operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed from, address indexed to)
+func (_Registry *RegistryFilterer) WatchOwnershipTransferred(opts *bind.WatchOpts, sink chan<- *RegistryOwnershipTransferred, from []common.Address, to []common.Address) (event.Subscription, error) {
+
+	var fromRule []interface{}
+	for _, fromItem := range from {
+		fromRule = append(fromRule, fromItem)
+	}
+	var toRule []interface{}
+	for _, toItem := range to {
+		toRule = append(toRule, toItem)
+	}
+
+	logs, sub, err := _Registry.contract.WatchLogs(opts, "OwnershipTransferred", fromRule, toRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(RegistryOwnershipTransferred)
+				if err := _Registry.contract.UnpackLog(event, "OwnershipTransferred", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseOwnershipTransferred is a log parse operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed from, address indexed to)
+func (_Registry *RegistryFilterer) ParseOwnershipTransferred(log types.Log) (*RegistryOwnershipTransferred, error) {
+	event := new(RegistryOwnershipTransferred)
+	if err := _Registry.contract.UnpackLog(event, "OwnershipTransferred", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
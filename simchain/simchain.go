@@ -0,0 +1,262 @@
+// Package simchain runs Chainlink feeds on go-ethereum's simulated backend,
+// so everything built on the monitor can be tested end to end offline.
+//
+// Feeds consist of a FluxAggregator behind an EACAggregatorProxy, both
+// deployed from Chainlink's compiled contracts. Every method that sends
+// transactions also mines them.
+package simchain
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind/backends"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+	"hw-3/simchain/eacproxy"
+	"hw-3/simchain/fluxaggregator"
+)
+
+const (
+	gasLimit       = 30_000_000
+	oracleCount    = 3
+	accountCount   = 2
+	defaultTimeout = 60
+	startOffset    = 24 * time.Hour
+)
+
+var (
+	accountBalance     = new(big.Int).Mul(big.NewInt(1000), big.NewInt(1e18))
+	minSubmissionValue = new(big.Int).Neg(new(big.Int).Exp(big.NewInt(10), big.NewInt(30), nil))
+	maxSubmissionValue = new(big.Int).Exp(big.NewInt(10), big.NewInt(30), nil)
+
+	errTxFailed = errors.New("transaction reverted")
+)
+
+// Chain is a simulated chain with funded accounts: the Owner deploying and
+// administering contracts, Oracles submitting answers and spare Accounts,
+// e.g. to transfer ownership to.
+type Chain struct {
+	Backend  *backends.SimulatedBackend
+	Owner    *bind.TransactOpts
+	Oracles  []*bind.TransactOpts
+	Accounts []*bind.TransactOpts
+}
+
+// Aggregator is a deployed FluxAggregator accepting answers from all Oracles
+// of the chain.
+type Aggregator struct {
+	Address  common.Address
+	Contract *fluxaggregator.FluxAggregator
+}
+
+// Feed is an aggregator proxy together with the aggregator it currently
+// points to.
+type Feed struct {
+	Address    common.Address
+	Contract   *eacproxy.EACAggregatorProxy
+	Aggregator *Aggregator
+}
+
+func newAccount(chainID *big.Int) (*bind.TransactOpts, error) {
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewKeyedTransactorWithChainID(key, chainID)
+}
+
+// New creates a chain with funded accounts and no contracts.
+func New() (*Chain, error) {
+	chainID := params.AllEthashProtocolChanges.ChainID
+	alloc := core.GenesisAlloc{}
+	accounts := make([]*bind.TransactOpts, 0, 1+oracleCount+accountCount)
+	for i := 0; i < cap(accounts); i++ {
+		opts, err := newAccount(chainID)
+		if err != nil {
+			return nil, fmt.Errorf("failed generating account: %w", err)
+		}
+		alloc[opts.From] = core.GenesisAccount{Balance: accountBalance}
+		accounts = append(accounts, opts)
+	}
+
+	chain := &Chain{
+		Backend:  backends.NewSimulatedBackend(alloc, gasLimit),
+		Owner:    accounts[0],
+		Oracles:  accounts[1 : 1+oracleCount],
+		Accounts: accounts[1+oracleCount:],
+	}
+	// The genesis block is dated 1970 while aggregators expect timestamps far from zero. Every block moves
+	// the clock 10 seconds forward and blocks from the future are not imported, so start a day in the past.
+	if err := chain.AdjustTime(time.Duration(time.Now().Add(-startOffset).Unix()) * time.Second); err != nil {
+		chain.Close()
+		return nil, fmt.Errorf("failed adjusting time: %w", err)
+	}
+	return chain, nil
+}
+
+// Close stops the simulated backend.
+func (c *Chain) Close() error {
+	return c.Backend.Close()
+}
+
+// Commit mines a block with all pending transactions.
+func (c *Chain) Commit() common.Hash {
+	return c.Backend.Commit()
+}
+
+// AdjustTime moves the chain clock forward and mines a block.
+func (c *Chain) AdjustTime(d time.Duration) error {
+	if err := c.Backend.AdjustTime(d); err != nil {
+		return err
+	}
+	c.Backend.Commit()
+	return nil
+}
+
+// mine commits the pending block and checks that all txs succeeded.
+func (c *Chain) mine(txs ...*types.Transaction) error {
+	c.Backend.Commit()
+	for _, tx := range txs {
+		receipt, err := c.Backend.TransactionReceipt(nil, tx.Hash())
+		if err != nil {
+			return fmt.Errorf("failed getting receipt of %s: %w", tx.Hash(), err)
+		}
+		if receipt.Status != types.ReceiptStatusSuccessful {
+			return fmt.Errorf("%s: %w", tx.Hash(), errTxFailed)
+		}
+	}
+	return nil
+}
+
+// DeployAggregator deploys an aggregator answering once minSubmissions oracles
+// submit to a round. Rounds time out after timeout seconds, zero means 60.
+func (c *Chain) DeployAggregator(decimals uint8, minSubmissions, timeout uint32) (*Aggregator, error) {
+	if timeout == 0 {
+		timeout = defaultTimeout
+	}
+	address, deployTx, contract, err := fluxaggregator.DeployFluxAggregator(c.Owner, c.Backend,
+		common.Address{}, new(big.Int), timeout, common.Address{},
+		minSubmissionValue, maxSubmissionValue, decimals, "simulated")
+	if err != nil {
+		return nil, fmt.Errorf("failed deploying aggregator: %w", err)
+	}
+	if err := c.mine(deployTx); err != nil {
+		return nil, fmt.Errorf("failed deploying aggregator: %w", err)
+	}
+
+	oracles := make([]common.Address, len(c.Oracles))
+	admins := make([]common.Address, len(c.Oracles))
+	for i, oracle := range c.Oracles {
+		oracles[i] = oracle.From
+		admins[i] = c.Owner.From
+	}
+	tx, err := contract.ChangeOracles(c.Owner, nil, oracles, admins, minSubmissions, uint32(len(oracles)), 0)
+	if err != nil {
+		return nil, fmt.Errorf("failed adding oracles: %w", err)
+	}
+	if err := c.mine(tx); err != nil {
+		return nil, fmt.Errorf("failed adding oracles: %w", err)
+	}
+	return &Aggregator{Address: address, Contract: contract}, nil
+}
+
+// Submit sends the answer of a single oracle to the given round.
+func (c *Chain) Submit(agg *Aggregator, oracle int, roundID uint32, answer *big.Int) error {
+	tx, err := agg.Contract.Submit(c.Oracles[oracle], big.NewInt(int64(roundID)), answer)
+	if err != nil {
+		return fmt.Errorf("failed submitting answer: %w", err)
+	}
+	return c.mine(tx)
+}
+
+// PushAnswer makes enough oracles submit answer to the next round for it to
+// be answered, all submissions are mined in a single block.
+func (c *Chain) PushAnswer(agg *Aggregator, answer *big.Int) error {
+	minSubmissions, err := agg.Contract.MinSubmissionCount(nil)
+	if err != nil {
+		return fmt.Errorf("failed getting min submissions: %w", err)
+	}
+	state, err := agg.Contract.OracleRoundState(nil, c.Oracles[0].From, 0)
+	if err != nil {
+		return fmt.Errorf("failed getting round state: %w", err)
+	}
+
+	txs := make([]*types.Transaction, 0, minSubmissions)
+	for i := 0; i < int(minSubmissions); i++ {
+		tx, err := agg.Contract.Submit(c.Oracles[i], big.NewInt(int64(state.RoundId)), answer)
+		if err != nil {
+			return fmt.Errorf("failed submitting answer: %w", err)
+		}
+		txs = append(txs, tx)
+	}
+	return c.mine(txs...)
+}
+
+// DeployFeed deploys an aggregator answered by a single oracle with
+// initialAnswer and a proxy pointing to it.
+func (c *Chain) DeployFeed(decimals uint8, initialAnswer *big.Int) (*Feed, error) {
+	agg, err := c.DeployAggregator(decimals, 1, 0)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.PushAnswer(agg, initialAnswer); err != nil {
+		return nil, err
+	}
+
+	address, tx, contract, err := eacproxy.DeployEACAggregatorProxy(c.Owner, c.Backend, agg.Address, common.Address{})
+	if err != nil {
+		return nil, fmt.Errorf("failed deploying proxy: %w", err)
+	}
+	if err := c.mine(tx); err != nil {
+		return nil, fmt.Errorf("failed deploying proxy: %w", err)
+	}
+	return &Feed{Address: address, Contract: contract, Aggregator: agg}, nil
+}
+
+// ProposeAggregator proposes agg as the next aggregator of feed.
+func (c *Chain) ProposeAggregator(feed *Feed, agg *Aggregator) error {
+	tx, err := feed.Contract.ProposeAggregator(c.Owner, agg.Address)
+	if err != nil {
+		return fmt.Errorf("failed proposing aggregator: %w", err)
+	}
+	return c.mine(tx)
+}
+
+// ConfirmAggregator switches feed to the previously proposed agg.
+func (c *Chain) ConfirmAggregator(feed *Feed, agg *Aggregator) error {
+	tx, err := feed.Contract.ConfirmAggregator(c.Owner, agg.Address)
+	if err != nil {
+		return fmt.Errorf("failed confirming aggregator: %w", err)
+	}
+	if err := c.mine(tx); err != nil {
+		return err
+	}
+	feed.Aggregator = agg
+	return nil
+}
+
+// TransferOwnership requests moving the ownership of feed from the current
+// owner to the given account.
+func (c *Chain) TransferOwnership(feed *Feed, from, to *bind.TransactOpts) error {
+	tx, err := feed.Contract.TransferOwnership(from, to.From)
+	if err != nil {
+		return fmt.Errorf("failed requesting ownership transfer: %w", err)
+	}
+	return c.mine(tx)
+}
+
+// AcceptOwnership completes a pending ownership transfer of feed.
+func (c *Chain) AcceptOwnership(feed *Feed, newOwner *bind.TransactOpts) error {
+	tx, err := feed.Contract.AcceptOwnership(newOwner)
+	if err != nil {
+		return fmt.Errorf("failed accepting ownership: %w", err)
+	}
+	return c.mine(tx)
+}
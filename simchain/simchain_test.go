@@ -0,0 +1,105 @@
+package simchain
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestFeedAnswers(t *testing.T) {
+	chain, err := New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer chain.Close()
+
+	feed, err := chain.DeployFeed(8, big.NewInt(100))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := chain.PushAnswer(feed.Aggregator, big.NewInt(200)); err != nil {
+		t.Fatal(err)
+	}
+
+	round, err := feed.Contract.LatestRoundData(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if round.Answer.Int64() != 200 {
+		t.Errorf("expected answer 200, got %s", round.Answer)
+	}
+	decimals, err := feed.Contract.Decimals(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if decimals != 8 {
+		t.Errorf("expected 8 decimals, got %d", decimals)
+	}
+}
+
+func TestAggregatorRotation(t *testing.T) {
+	chain, err := New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer chain.Close()
+
+	feed, err := chain.DeployFeed(8, big.NewInt(100))
+	if err != nil {
+		t.Fatal(err)
+	}
+	next, err := chain.DeployAggregator(8, 1, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := chain.PushAnswer(next, big.NewInt(300)); err != nil {
+		t.Fatal(err)
+	}
+	if err := chain.ProposeAggregator(feed, next); err != nil {
+		t.Fatal(err)
+	}
+	proposed, err := feed.Contract.ProposedLatestRoundData(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if proposed.Answer.Int64() != 300 {
+		t.Errorf("expected proposed answer 300, got %s", proposed.Answer)
+	}
+
+	if err := chain.ConfirmAggregator(feed, next); err != nil {
+		t.Fatal(err)
+	}
+	current, err := feed.Contract.Aggregator(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if current != next.Address {
+		t.Errorf("expected aggregator %s, got %s", next.Address, current)
+	}
+}
+
+func TestOwnershipTransfer(t *testing.T) {
+	chain, err := New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer chain.Close()
+
+	feed, err := chain.DeployFeed(8, big.NewInt(100))
+	if err != nil {
+		t.Fatal(err)
+	}
+	newOwner := chain.Accounts[0]
+	if err := chain.TransferOwnership(feed, chain.Owner, newOwner); err != nil {
+		t.Fatal(err)
+	}
+	if err := chain.AcceptOwnership(feed, newOwner); err != nil {
+		t.Fatal(err)
+	}
+	owner, err := feed.Contract.Owner(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if owner != newOwner.From {
+		t.Errorf("expected owner %s, got %s", newOwner.From, owner)
+	}
+}
@@ -0,0 +1,119 @@
+package sink
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+)
+
+const (
+	// StdoutPath makes file sinks write to the standard output.
+	StdoutPath = "-"
+)
+
+func openOutput(path string) (io.WriteCloser, bool, error) {
+	if path == StdoutPath {
+		return nopCloser{os.Stdout}, true, nil
+	}
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed opening output file %s: %w", path, err)
+	}
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return nil, false, fmt.Errorf("failed reading output file %s: %w", path, err)
+	}
+	return file, info.Size() == 0, nil
+}
+
+type nopCloser struct {
+	io.Writer
+}
+
+func (nopCloser) Close() error {
+	return nil
+}
+
+// JSONLines writes every record as a JSON object on its own line.
+type JSONLines struct {
+	out     io.WriteCloser
+	encoder *json.Encoder
+}
+
+// NewJSONLines appends records to the file at path, StdoutPath writes them
+// to the standard output.
+func NewJSONLines(path string) (*JSONLines, error) {
+	out, _, err := openOutput(path)
+	if err != nil {
+		return nil, err
+	}
+	return &JSONLines{out: out, encoder: json.NewEncoder(out)}, nil
+}
+
+// Send writes rec as a single line.
+func (s *JSONLines) Send(rec *Record) error {
+	return s.encoder.Encode(rec)
+}
+
+// Close closes the output file.
+func (s *JSONLines) Close() error {
+	return s.out.Close()
+}
+
+var csvHeader = []string{"time", "type", "subject", "address", "value", "fields"}
+
+// CSV writes every record as a row, fields specific to the event type are
+// stored as a JSON object in the last column.
+type CSV struct {
+	out    io.WriteCloser
+	writer *csv.Writer
+}
+
+// NewCSV appends records to the file at path, StdoutPath writes them to the
+// standard output. The header is written if the file is empty.
+func NewCSV(path string) (*CSV, error) {
+	out, empty, err := openOutput(path)
+	if err != nil {
+		return nil, err
+	}
+	s := &CSV{out: out, writer: csv.NewWriter(out)}
+	if empty {
+		if err := s.write(csvHeader); err != nil {
+			out.Close()
+			return nil, err
+		}
+	}
+	return s, nil
+}
+
+func (s *CSV) write(row []string) error {
+	if err := s.writer.Write(row); err != nil {
+		return err
+	}
+	s.writer.Flush()
+	return s.writer.Error()
+}
+
+// Send writes rec as a single row.
+func (s *CSV) Send(rec *Record) error {
+	fields, err := json.Marshal(rec.Fields)
+	if err != nil {
+		return err
+	}
+	return s.write([]string{
+		formatTime(rec.Time),
+		rec.Type,
+		rec.Subject,
+		rec.Address,
+		rec.Value,
+		string(fields),
+	})
+}
+
+// Close closes the output file.
+func (s *CSV) Close() error {
+	return s.out.Close()
+}
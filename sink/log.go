@@ -0,0 +1,275 @@
+package sink
+
+import (
+	log "github.com/sirupsen/logrus"
+	"hw-3/monitor"
+)
+
+func feedFields(feed monitor.Feed) log.Fields {
+	fields := log.Fields{
+		"feedAddress": feed.Address.Hex(),
+		"tokens":      feed.Tokens,
+	}
+	if feed.Name != "" {
+		fields["name"] = feed.Name
+	}
+	if feed.Registry() {
+		fields["base"] = feed.Base.Hex()
+		fields["quote"] = feed.Quote.Hex()
+	}
+	return fields
+}
+
+func describeSubscribed(ev *monitor.Subscribed) (string, log.Fields) {
+	switch ev.Kind {
+	case monitor.SubscriptionPrice:
+		return "Monitoring price", log.Fields{
+			"feedAddress": ev.Address.Hex(),
+			"tokens":      ev.Name,
+		}
+	case monitor.SubscriptionGovernance:
+		return "Monitoring governance", log.Fields{
+			"feedAddress": ev.Address.Hex(),
+			"tokens":      ev.Name,
+			"aggregator":  ev.Aggregator.Hex(),
+		}
+	case monitor.SubscriptionOwnership:
+		return "Monitoring ownership", log.Fields{
+			"contract":        ev.Name,
+			"contractAddress": ev.Address.Hex(),
+		}
+	case monitor.SubscriptionDEX:
+		return "Monitoring DEX pool", log.Fields{
+			"tokens":      ev.Name,
+			"poolAddress": ev.Address.Hex(),
+		}
+	case monitor.SubscriptionPending:
+		return "Monitoring pending transactions", log.Fields{}
+	case monitor.SubscriptionContract:
+		return "Monitoring contract events", log.Fields{
+			"contract":        ev.Name,
+			"contractAddress": ev.Address.Hex(),
+			"events":          ev.Events,
+		}
+	default:
+		return "Monitoring blocks", log.Fields{}
+	}
+}
+
+func governanceFields(ev *monitor.GovernanceAlert) log.Fields {
+	fields := feedFields(ev.Feed)
+	fields["alert"] = string(ev.Kind)
+	switch ev.Kind {
+	case monitor.AggregatorProposed:
+		fields["current"] = ev.From.Hex()
+		fields["proposed"] = ev.To.Hex()
+	case monitor.ProposalWithdrawn:
+		fields["proposed"] = ev.To.Hex()
+	case monitor.AggregatorConfirmed, monitor.NameChanged:
+		fields["from"] = ev.From.Hex()
+		fields["to"] = ev.To.Hex()
+	default:
+		fields["from"] = ev.From.Hex()
+		fields["to"] = ev.To.Hex()
+		fields["txHash"] = ev.TxHash.Hex()
+	}
+	return fields
+}
+
+// Log writes records to logrus. By default the fields are meant for people
+// reading the text output, structured logs use the versioned field names of
+// SchemaFields instead.
+type Log struct {
+	structured bool
+}
+
+// NewLog creates a Log sink with human readable fields.
+func NewLog() *Log {
+	return &Log{}
+}
+
+// NewStructuredLog creates a Log sink writing SchemaFields, meant to be used
+// with a machine readable formatter such as logrus.JSONFormatter.
+func NewStructuredLog() *Log {
+	return &Log{structured: true}
+}
+
+// Send logs the event behind rec.
+func (l *Log) Send(rec *Record) error {
+	level, msg, fields := describeEvent(rec.Event)
+	if l.structured {
+		fields = SchemaFields(rec)
+	}
+	log.WithFields(fields).Log(level, msg)
+	return nil
+}
+
+// Close does nothing, logrus needs no cleanup.
+func (*Log) Close() error {
+	return nil
+}
+
+// describeEvent returns the level, message and human readable fields ev is
+// logged with.
+func describeEvent(ev monitor.Event) (log.Level, string, log.Fields) {
+	switch ev := ev.(type) {
+	case *monitor.Subscribed:
+		msg, fields := describeSubscribed(ev)
+		return log.InfoLevel, msg, fields
+	case *monitor.NewBlock:
+		return log.InfoLevel, "New block", log.Fields{
+			"number":       ev.Number,
+			"transactions": ev.Transactions,
+		}
+	case *monitor.PriceUpdate:
+		fields := log.Fields{
+			"tokens": ev.Feed.Tokens,
+			"price":  ev.Price.String(),
+		}
+		if ev.Unreliable {
+			fields["unreliable"] = true
+			return log.WarnLevel, "New price", fields
+		}
+		return log.InfoLevel, "New price", fields
+	case *monitor.UpdateCost:
+		return log.InfoLevel, "Update cost", log.Fields{
+			"tokens":            ev.Feed.Tokens,
+			"roundId":           ev.RoundID.String(),
+			"txHash":            ev.TxHash.Hex(),
+			"transmitter":       ev.Transmitter.Hex(),
+			"gasUsed":           ev.GasUsed,
+			"effectiveGasPrice": ev.EffectiveGasPrice.String(),
+			"costEth":           ev.Cost.String(),
+		}
+	case *monitor.PriceReverted:
+		fields := log.Fields{
+			"tokens":    ev.Feed.Tokens,
+			"price":     ev.Price.String(),
+			"roundId":   ev.RoundID.String(),
+			"blockHash": ev.Log.BlockHash.Hex(),
+		}
+		if ev.Unreliable {
+			fields["unreliable"] = true
+		}
+		return log.WarnLevel, "Price update reverted by reorg", fields
+	case *monitor.Transmission:
+		fields := feedFields(ev.Feed)
+		fields["roundId"] = ev.RoundID
+		fields["price"] = ev.Price.String()
+		fields["transmitter"] = ev.Transmitter.Hex()
+		fields["observers"] = len(ev.Observers)
+		fields["median"] = ev.Median.String()
+		fields["spreadPercent"] = ev.SpreadPercent
+		if ev.Unreliable {
+			fields["unreliable"] = true
+		}
+		return log.InfoLevel, "New transmission", fields
+	case *monitor.PendingPrice:
+		fields := feedFields(ev.Feed)
+		fields["price"] = ev.Price.String()
+		fields["txHash"] = ev.TxHash.Hex()
+		fields["transmitter"] = ev.Transmitter.Hex()
+		fields["observers"] = len(ev.Observers)
+		if ev.Unreliable {
+			fields["unreliable"] = true
+		}
+		return log.InfoLevel, "Pending price", fields
+	case *monitor.SequencerStatus:
+		if !ev.Up {
+			return log.WarnLevel, "Sequencer down", log.Fields{
+				"uptimeFeed": ev.Feed.Hex(),
+				"since":      ev.Since,
+			}
+		}
+		return log.InfoLevel, "Sequencer up", log.Fields{
+			"uptimeFeed":     ev.Feed.Hex(),
+			"since":          ev.Since,
+			"gracePeriodEnd": ev.GracePeriodEnd,
+		}
+	case *monitor.Divergence:
+		prices := make(map[string]string, len(ev.Prices))
+		for _, price := range ev.Prices {
+			prices[price.Name] = price.Price.String()
+		}
+		fields := log.Fields{
+			"group":             ev.Group,
+			"since":             ev.Since,
+			"prices":            prices,
+			"divergencePercent": ev.DivergencePercent,
+		}
+		if ev.Unreliable {
+			fields["unreliable"] = true
+		}
+		if ev.Diverged {
+			return log.WarnLevel, "Feeds diverged", fields
+		}
+		return log.InfoLevel, "Feeds converged", fields
+	case *monitor.RoundStarted:
+		return log.InfoLevel, "New round", log.Fields{
+			"tokens":    ev.Feed.Tokens,
+			"roundId":   ev.RoundID,
+			"startedBy": ev.StartedBy.Hex(),
+		}
+	case *monitor.RoundAnswered:
+		return log.InfoLevel, "Round answered", log.Fields{
+			"tokens":    ev.Feed.Tokens,
+			"roundId":   ev.RoundID,
+			"startedBy": ev.StartedBy.Hex(),
+			"duration":  ev.Duration,
+		}
+	case *monitor.RoundUnanswered:
+		return log.WarnLevel, "Round not answered", log.Fields{
+			"tokens":    ev.Feed.Tokens,
+			"roundId":   ev.RoundID,
+			"startedBy": ev.StartedBy.Hex(),
+			"startedAt": ev.StartedAt,
+			"reason":    ev.Reason,
+		}
+	case *monitor.GovernanceAlert:
+		return log.WarnLevel, "Governance change", governanceFields(ev)
+	case *monitor.ProposedDeviation:
+		fields := feedFields(ev.Feed)
+		fields["proposed"] = ev.Proposed.Hex()
+		fields["currentPrice"] = ev.CurrentPrice.String()
+		fields["proposedPrice"] = ev.ProposedPrice.String()
+		fields["currentRoundId"] = ev.CurrentRoundID.String()
+		fields["proposedRoundId"] = ev.ProposedRoundID.String()
+		fields["proposedUpdatedAt"] = ev.ProposedUpdatedAt.Unix()
+		fields["deviationPercent"] = ev.DeviationPercent
+		return log.InfoLevel, "Proposed aggregator deviation", fields
+	case *monitor.DEXDeviation:
+		fields := feedFields(ev.Feed)
+		fields["pool"] = ev.Pool.Hex()
+		fields["kind"] = ev.Kind
+		fields["block"] = ev.Block
+		fields["dexPrice"] = ev.DEXPrice.String()
+		fields["chainlinkPrice"] = ev.ChainlinkPrice.String()
+		fields["roundId"] = ev.ChainlinkRoundID.String()
+		fields["deviationPercent"] = ev.DeviationPercent
+		if ev.Unreliable {
+			fields["unreliable"] = true
+		}
+		return log.InfoLevel, "DEX price deviation", fields
+	case *monitor.ContractEvent:
+		return log.InfoLevel, "Contract event", log.Fields{
+			"contract": ev.Contract,
+			"event":    ev.Event,
+			"args":     ev.Args,
+			"block":    ev.Log.BlockNumber,
+			"txHash":   ev.Log.TxHash.Hex(),
+			"logIndex": ev.Log.Index,
+			"removed":  ev.Log.Removed,
+		}
+	case *monitor.BlockError:
+		return log.ErrorLevel, ev.Error(), log.Fields{}
+	case *monitor.FeedError:
+		return log.ErrorLevel, ev.Error(), feedFields(ev.Feed)
+	case *monitor.ContractError:
+		return log.ErrorLevel, ev.Error(), log.Fields{
+			"contract":        ev.Contract,
+			"contractAddress": ev.Address.Hex(),
+		}
+	default:
+		return log.InfoLevel, "Unknown event", log.Fields{}
+	}
+}
@@ -0,0 +1,263 @@
+package sink
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"hw-3/monitor"
+)
+
+// Record is a flat representation of a monitor event shared by all sinks.
+// Subject is the tokens of a feed or the name of a contract, Value is the main
+// measurement of the event, e.g. the price or the block number. The names and
+// meaning of all fields are versioned by Schema, see SchemaVersion.
+type Record struct {
+	Schema  int               `json:"schema"`
+	Network string            `json:"network,omitempty"`
+	Time    time.Time         `json:"time"`
+	Type    string            `json:"type"`
+	Subject string            `json:"subject,omitempty"`
+	Address string            `json:"address,omitempty"`
+	Value   string            `json:"value,omitempty"`
+	Fields  map[string]string `json:"fields,omitempty"`
+	Event   monitor.Event     `json:"-"`
+}
+
+func formatUint(value uint64) string {
+	return strconv.FormatUint(value, 10)
+}
+
+func formatTime(value time.Time) string {
+	return value.UTC().Format(time.RFC3339)
+}
+
+func formatSeconds(value time.Duration) string {
+	return strconv.FormatFloat(value.Seconds(), 'f', 3, 64)
+}
+
+// setLogFields identifies the log an event was decoded from.
+func setLogFields(rec *Record, vLog types.Log) {
+	rec.Fields["block"] = formatUint(vLog.BlockNumber)
+	rec.Fields["blockHash"] = vLog.BlockHash.Hex()
+	rec.Fields["txHash"] = vLog.TxHash.Hex()
+	rec.Fields["logIndex"] = formatUint(uint64(vLog.Index))
+	if vLog.Removed {
+		rec.Fields["removed"] = "true"
+	}
+}
+
+// NewRecord converts ev received at the given time from network to a Record.
+func NewRecord(at time.Time, network string, ev monitor.Event) *Record {
+	rec := &Record{
+		Schema:  SchemaVersion,
+		Network: network,
+		Time:    at,
+		Event:   ev,
+		Fields:  make(map[string]string),
+	}
+	switch ev := ev.(type) {
+	case *monitor.Subscribed:
+		rec.Type = "subscribed"
+		rec.Subject = ev.Name
+		rec.Fields["kind"] = string(ev.Kind)
+		if ev.Kind != monitor.SubscriptionBlocks {
+			rec.Address = ev.Address.Hex()
+		}
+		if ev.Kind == monitor.SubscriptionPrice || ev.Kind == monitor.SubscriptionGovernance {
+			rec.Fields["aggregator"] = ev.Aggregator.Hex()
+		}
+	case *monitor.NewBlock:
+		rec.Type = "block"
+		rec.Value = formatUint(ev.Number)
+		rec.Fields["hash"] = ev.Hash.Hex()
+		rec.Fields["time"] = formatTime(ev.Time)
+		rec.Fields["transactions"] = strconv.Itoa(ev.Transactions)
+		if ev.BaseFee != nil {
+			rec.Fields["baseFee"] = ev.BaseFee.String()
+		}
+	case *monitor.GasUpdate:
+		rec.Type = "gas"
+		rec.Value = ev.Estimate.Suggestions["standard"].MaxFee.String()
+		rec.Fields["block"] = formatUint(ev.Estimate.Block)
+		rec.Fields["nextBaseFee"] = ev.Estimate.NextBaseFee.String()
+		for speed, suggestion := range ev.Estimate.Suggestions {
+			rec.Fields[speed+"MaxPriorityFee"] = suggestion.MaxPriorityFee.String()
+			rec.Fields[speed+"MaxFee"] = suggestion.MaxFee.String()
+		}
+	case *monitor.PriceUpdate:
+		rec.Type = "price"
+		rec.Subject = ev.Feed.Tokens
+		rec.Address = ev.Feed.Address.Hex()
+		rec.Value = ev.Price.String()
+		rec.Fields["roundId"] = ev.RoundID.String()
+		rec.Fields["phaseId"] = formatUint(uint64(ev.PhaseID))
+		rec.Fields["proxyRoundId"] = monitor.ProxyRoundID(ev.PhaseID, ev.RoundID).String()
+		rec.Fields["answer"] = ev.Answer.String()
+		rec.Fields["decimals"] = formatUint(uint64(ev.Decimals))
+		rec.Fields["updatedAt"] = formatTime(ev.UpdatedAt)
+		if !ev.BlockTime.IsZero() {
+			rec.Fields["blockTime"] = formatTime(ev.BlockTime)
+			rec.Fields["blockLatency"] = formatSeconds(ev.BlockLatency())
+			rec.Fields["receiveLatency"] = formatSeconds(ev.ReceiveLatency())
+		}
+		if ev.Unreliable {
+			rec.Fields["unreliable"] = "true"
+		}
+		setLogFields(rec, ev.Log)
+	case *monitor.UpdateCost:
+		rec.Type = "updateCost"
+		rec.Subject = ev.Feed.Tokens
+		rec.Address = ev.Feed.Address.Hex()
+		rec.Value = ev.Cost.String()
+		rec.Fields["roundId"] = ev.RoundID.String()
+		rec.Fields["txHash"] = ev.TxHash.Hex()
+		rec.Fields["transmitter"] = ev.Transmitter.Hex()
+		rec.Fields["gasUsed"] = formatUint(ev.GasUsed)
+		rec.Fields["effectiveGasPrice"] = ev.EffectiveGasPrice.String()
+	case *monitor.PriceReverted:
+		rec.Type = "priceReverted"
+		rec.Subject = ev.Feed.Tokens
+		rec.Address = ev.Feed.Address.Hex()
+		rec.Value = ev.Price.String()
+		rec.Fields["roundId"] = ev.RoundID.String()
+		rec.Fields["answer"] = ev.Answer.String()
+		rec.Fields["decimals"] = formatUint(uint64(ev.Decimals))
+		if ev.Unreliable {
+			rec.Fields["unreliable"] = "true"
+		}
+		setLogFields(rec, ev.Log)
+	case *monitor.RoundStarted:
+		rec.Type = "roundStarted"
+		rec.Subject = ev.Feed.Tokens
+		rec.Address = ev.Feed.Address.Hex()
+		rec.Value = formatUint(ev.RoundID)
+		rec.Fields["startedBy"] = ev.StartedBy.Hex()
+		rec.Fields["startedAt"] = formatTime(ev.StartedAt)
+	case *monitor.RoundAnswered:
+		rec.Type = "roundAnswered"
+		rec.Subject = ev.Feed.Tokens
+		rec.Address = ev.Feed.Address.Hex()
+		rec.Value = formatUint(ev.RoundID)
+		rec.Fields["startedBy"] = ev.StartedBy.Hex()
+		rec.Fields["duration"] = ev.Duration.String()
+	case *monitor.RoundUnanswered:
+		rec.Type = "roundUnanswered"
+		rec.Subject = ev.Feed.Tokens
+		rec.Address = ev.Feed.Address.Hex()
+		rec.Value = formatUint(ev.RoundID)
+		rec.Fields["startedBy"] = ev.StartedBy.Hex()
+		rec.Fields["startedAt"] = formatTime(ev.StartedAt)
+		rec.Fields["reason"] = ev.Reason
+	case *monitor.GovernanceAlert:
+		rec.Type = "governance"
+		rec.Subject = ev.Feed.Tokens
+		rec.Address = ev.Feed.Address.Hex()
+		rec.Value = string(ev.Kind)
+		rec.Fields["from"] = ev.From.Hex()
+		rec.Fields["to"] = ev.To.Hex()
+		if ev.Kind == monitor.OwnershipTransferRequested || ev.Kind == monitor.OwnershipTransferred {
+			rec.Fields["txHash"] = ev.TxHash.Hex()
+		}
+	case *monitor.ProposedDeviation:
+		rec.Type = "proposedDeviation"
+		rec.Subject = ev.Feed.Tokens
+		rec.Address = ev.Feed.Address.Hex()
+		rec.Value = strconv.FormatFloat(ev.DeviationPercent, 'f', 4, 64)
+		rec.Fields["proposed"] = ev.Proposed.Hex()
+		rec.Fields["currentPrice"] = ev.CurrentPrice.String()
+		rec.Fields["proposedPrice"] = ev.ProposedPrice.String()
+	case *monitor.DEXDeviation:
+		rec.Type = "dexDeviation"
+		rec.Subject = ev.Feed.Tokens
+		rec.Address = ev.Feed.Address.Hex()
+		rec.Value = strconv.FormatFloat(ev.DeviationPercent, 'f', 4, 64)
+		rec.Fields["pool"] = ev.Pool.Hex()
+		rec.Fields["kind"] = string(ev.Kind)
+		rec.Fields["block"] = formatUint(ev.Block)
+		rec.Fields["dexPrice"] = ev.DEXPrice.String()
+		rec.Fields["chainlinkPrice"] = ev.ChainlinkPrice.String()
+		rec.Fields["roundId"] = ev.ChainlinkRoundID.String()
+		if ev.Unreliable {
+			rec.Fields["unreliable"] = "true"
+		}
+	case *monitor.Transmission:
+		rec.Type = "transmission"
+		rec.Subject = ev.Feed.Tokens
+		rec.Address = ev.Feed.Address.Hex()
+		rec.Value = ev.Price.String()
+		rec.Fields["roundId"] = formatUint(uint64(ev.RoundID))
+		rec.Fields["answer"] = ev.Answer.String()
+		rec.Fields["decimals"] = formatUint(uint64(ev.Decimals))
+		rec.Fields["transmitter"] = ev.Transmitter.Hex()
+		rec.Fields["observers"] = strconv.Itoa(len(ev.Observers))
+		rec.Fields["median"] = ev.Median.String()
+		rec.Fields["spread"] = ev.Spread.String()
+		rec.Fields["spreadPercent"] = strconv.FormatFloat(ev.SpreadPercent, 'f', 4, 64)
+		if ev.Unreliable {
+			rec.Fields["unreliable"] = "true"
+		}
+		setLogFields(rec, ev.Log)
+	case *monitor.PendingPrice:
+		rec.Type = "pendingPrice"
+		rec.Subject = ev.Feed.Tokens
+		rec.Address = ev.Feed.Address.Hex()
+		rec.Value = ev.Price.String()
+		rec.Fields["aggregator"] = ev.Aggregator.Hex()
+		rec.Fields["answer"] = ev.Answer.String()
+		rec.Fields["decimals"] = formatUint(uint64(ev.Decimals))
+		rec.Fields["txHash"] = ev.TxHash.Hex()
+		rec.Fields["transmitter"] = ev.Transmitter.Hex()
+		rec.Fields["observers"] = strconv.Itoa(len(ev.Observers))
+		rec.Fields["seenAt"] = formatTime(ev.SeenAt)
+		if ev.Unreliable {
+			rec.Fields["unreliable"] = "true"
+		}
+	case *monitor.SequencerStatus:
+		rec.Type = "sequencer"
+		rec.Address = ev.Feed.Hex()
+		rec.Value = "down"
+		rec.Fields["since"] = formatTime(ev.Since)
+		if ev.Up {
+			rec.Value = "up"
+			rec.Fields["gracePeriodEnd"] = formatTime(ev.GracePeriodEnd)
+		}
+	case *monitor.Divergence:
+		rec.Type = "divergence"
+		rec.Subject = ev.Group
+		rec.Value = strconv.FormatFloat(ev.DivergencePercent, 'f', 4, 64)
+		rec.Fields["diverged"] = strconv.FormatBool(ev.Diverged)
+		rec.Fields["since"] = formatTime(ev.Since)
+		for _, price := range ev.Prices {
+			rec.Fields["price."+price.Name] = price.Price.String()
+		}
+		if ev.Unreliable {
+			rec.Fields["unreliable"] = "true"
+		}
+	case *monitor.ContractEvent:
+		rec.Type = "contractEvent"
+		rec.Subject = ev.Contract
+		rec.Address = ev.Log.Address.Hex()
+		rec.Value = ev.Event
+		for name, value := range ev.Args {
+			rec.Fields["arg."+name] = value
+		}
+		setLogFields(rec, ev.Log)
+	case *monitor.BlockError:
+		rec.Type = "blockError"
+		rec.Value = ev.Error()
+	case *monitor.FeedError:
+		rec.Type = "feedError"
+		rec.Subject = ev.Feed.Tokens
+		rec.Address = ev.Feed.Address.Hex()
+		rec.Value = ev.Error()
+	case *monitor.ContractError:
+		rec.Type = "contractError"
+		rec.Subject = ev.Contract
+		rec.Address = ev.Address.Hex()
+		rec.Value = ev.Error()
+	default:
+		rec.Type = "unknown"
+	}
+	return rec
+}
@@ -0,0 +1,69 @@
+package sink
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"hw-3/decimal"
+	"hw-3/monitor"
+)
+
+func TestPriceSchema(t *testing.T) {
+	ev := &monitor.PriceUpdate{
+		Feed:     monitor.Feed{Tokens: "ETH / USD", Address: common.HexToAddress("0x5f4eC3Df9cbd43714FE2740f5E3616155c5b8419")},
+		PhaseID:  5,
+		RoundID:  big.NewInt(42),
+		Answer:   big.NewInt(123456789012),
+		Decimals: 8,
+		Price:    decimal.New(big.NewInt(123456789012), 8),
+		Log: types.Log{
+			BlockNumber: 15884853,
+			BlockHash:   common.HexToHash("0x01"),
+			TxHash:      common.HexToHash("0x02"),
+			Index:       7,
+		},
+	}
+	rec := NewRecord(time.Unix(0, 0), "mainnet", ev)
+
+	encoded, err := json.Marshal(SchemaFields(rec))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decoded struct {
+		Schema  int               `json:"schema"`
+		Network string            `json:"network"`
+		Type    string            `json:"type"`
+		Subject string            `json:"subject"`
+		Value   string            `json:"value"`
+		Fields  map[string]string `json:"fields"`
+	}
+	if err := json.Unmarshal(encoded, &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if decoded.Schema != SchemaVersion || decoded.Network != "mainnet" || decoded.Type != "price" {
+		t.Errorf("unexpected header %s", encoded)
+	}
+	if decoded.Subject != "ETH / USD" || decoded.Value != "1234.56789012" {
+		t.Errorf("unexpected subject or value %s", encoded)
+	}
+	expected := map[string]string{
+		"roundId":      "42",
+		"phaseId":      "5",
+		"proxyRoundId": "92233720368547758122",
+		"answer":       "123456789012",
+		"decimals":     "8",
+		"block":        "15884853",
+		"blockHash":    common.HexToHash("0x01").Hex(),
+		"txHash":       common.HexToHash("0x02").Hex(),
+		"logIndex":     "7",
+	}
+	for name, value := range expected {
+		if decoded.Fields[name] != value {
+			t.Errorf("expected %s=%s, got %q", name, value, decoded.Fields[name])
+		}
+	}
+}
@@ -0,0 +1,38 @@
+package sink
+
+import (
+	log "github.com/sirupsen/logrus"
+)
+
+// SchemaVersion is the version of the record layout: the record types, the
+// keys of their Fields and the meaning of the values. It is increased
+// whenever one of them is renamed, removed or changes meaning, adding new
+// types or keys keeps the version.
+const SchemaVersion = 1
+
+// SchemaFields returns the logrus fields of rec: schema, network, type,
+// subject, address and value at the top level and the fields specific to the
+// record type nested under fields, so they never clash with the fields added
+// by formatters.
+func SchemaFields(rec *Record) log.Fields {
+	fields := log.Fields{
+		"schema": rec.Schema,
+		"type":   rec.Type,
+	}
+	if rec.Network != "" {
+		fields["network"] = rec.Network
+	}
+	if rec.Subject != "" {
+		fields["subject"] = rec.Subject
+	}
+	if rec.Address != "" {
+		fields["address"] = rec.Address
+	}
+	if rec.Value != "" {
+		fields["value"] = rec.Value
+	}
+	if len(rec.Fields) > 0 {
+		fields["fields"] = rec.Fields
+	}
+	return fields
+}
@@ -0,0 +1,105 @@
+// Package sink delivers monitor events to logs, files and remote endpoints.
+package sink
+
+import (
+	"sync"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// Sink receives every record produced from monitor events.
+type Sink interface {
+	Send(rec *Record) error
+	Close() error
+}
+
+// Queued runs a Sink in its own goroutine behind a buffered queue, so a slow
+// sink cannot block the producer. Records that do not fit into the queue are
+// dropped.
+type Queued struct {
+	name    string
+	sink    Sink
+	queue   chan *Record
+	done    chan struct{}
+	dropped uint64
+}
+
+// NewQueued starts delivering records to sink through a queue of the given size.
+func NewQueued(name string, sink Sink, size int) *Queued {
+	q := &Queued{
+		name:  name,
+		sink:  sink,
+		queue: make(chan *Record, size),
+		done:  make(chan struct{}),
+	}
+	go q.run()
+	return q
+}
+
+func (q *Queued) run() {
+	defer close(q.done)
+	for rec := range q.queue {
+		if err := q.sink.Send(rec); err != nil {
+			log.WithField("sink", q.name).Errorf("Failed sending event: %s", err)
+		}
+	}
+}
+
+// Send enqueues rec without blocking.
+func (q *Queued) Send(rec *Record) error {
+	select {
+	case q.queue <- rec:
+	default:
+		q.dropped++
+		if q.dropped&(q.dropped-1) == 0 {
+			// Only report powers of two to avoid flooding the log when a sink is stuck
+			log.WithFields(log.Fields{
+				"sink":    q.name,
+				"dropped": q.dropped,
+			}).Warn("Sink queue is full, dropping events")
+		}
+	}
+	return nil
+}
+
+// Close delivers the records left in the queue and closes the underlying sink.
+// Send must not be called after Close.
+func (q *Queued) Close() error {
+	close(q.queue)
+	<-q.done
+	return q.sink.Close()
+}
+
+// Multi sends every record to all of its sinks.
+type Multi []Sink
+
+// Send passes rec to every sink, all of them are tried even if some fail.
+func (m Multi) Send(rec *Record) error {
+	var firstErr error
+	for _, s := range m {
+		if err := s.Send(rec); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
+
+// Close closes all sinks concurrently and returns the first error.
+func (m Multi) Close() error {
+	errs := make([]error, len(m))
+	wg := sync.WaitGroup{}
+	wg.Add(len(m))
+	for i, s := range m {
+		go func(i int, s Sink) {
+			defer wg.Done()
+			errs[i] = s.Close()
+		}(i, s)
+	}
+	wg.Wait()
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
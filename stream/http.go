@@ -0,0 +1,160 @@
+package stream
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/gorilla/websocket"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	heartbeatInterval = 15 * time.Second
+	writeTimeout      = 10 * time.Second
+)
+
+// gapMessage tells clients that the events since their resume token are not
+// all buffered anymore and they should reload the current state.
+var gapMessage = []byte(`{"type":"gap"}`)
+
+var upgrader = websocket.Upgrader{
+	// The stream is read only, pages from any origin may subscribe
+	CheckOrigin: func(r *http.Request) bool { return true },
+}
+
+// parseFilter reads the filter from the query: feed may be repeated or hold
+// a comma separated list of tokens or addresses, blocks selects blocks.
+func parseFilter(query url.Values) Filter {
+	filter := Filter{}
+	for _, feeds := range query["feed"] {
+		for _, feed := range strings.Split(feeds, ",") {
+			if feed = strings.TrimSpace(feed); feed != "" {
+				filter.Feeds = append(filter.Feeds, feed)
+			}
+		}
+	}
+	_, filter.Blocks = query["blocks"]
+	return filter
+}
+
+// SSEHandler streams records as Server-Sent Events named after the record
+// type. Reconnecting EventSource clients resume from their Last-Event-ID
+// header, other clients may pass the token as the resume query parameter.
+func (h *Hub) SSEHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		flusher, ok := w.(http.Flusher)
+		if !ok {
+			http.Error(w, "streaming unsupported", http.StatusInternalServerError)
+			return
+		}
+		resume := r.Header.Get("Last-Event-ID")
+		if resume == "" {
+			resume = r.URL.Query().Get("resume")
+		}
+
+		c, backlog, complete := h.subscribe(parseFilter(r.URL.Query()), resume)
+		defer h.unsubscribe(c)
+
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.Header().Set("Cache-Control", "no-cache")
+		w.WriteHeader(http.StatusOK)
+		if !complete {
+			fmt.Fprintf(w, "event: gap\ndata: %s\n\n", gapMessage)
+		}
+		for _, msg := range backlog {
+			writeEvent(w, msg)
+		}
+		flusher.Flush()
+
+		heartbeat := time.NewTicker(heartbeatInterval)
+		defer heartbeat.Stop()
+		for {
+			select {
+			case msg, ok := <-c.messages:
+				if !ok {
+					return
+				}
+				writeEvent(w, msg)
+			case <-heartbeat.C:
+				fmt.Fprint(w, ": heartbeat\n\n")
+			case <-r.Context().Done():
+				return
+			}
+			flusher.Flush()
+		}
+	}
+}
+
+func writeEvent(w http.ResponseWriter, msg *message) {
+	fmt.Fprintf(w, "id: %s\nevent: %s\ndata: %s\n\n", msg.id, msg.typ, msg.data)
+}
+
+// WebsocketHandler streams records as JSON text messages carrying their
+// resume token in the id field. Reconnecting clients pass the token of the
+// last message they received as the resume query parameter.
+func (h *Hub) WebsocketHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			// The upgrader has already replied with an error
+			return
+		}
+		defer conn.Close()
+
+		c, backlog, complete := h.subscribe(parseFilter(r.URL.Query()), r.URL.Query().Get("resume"))
+		defer h.unsubscribe(c)
+
+		// Clients only send close and control frames, reading processes them
+		disconnected := make(chan struct{})
+		go func() {
+			defer close(disconnected)
+			for {
+				if _, _, err := conn.NextReader(); err != nil {
+					return
+				}
+			}
+		}()
+
+		write := func(data []byte) bool {
+			conn.SetWriteDeadline(time.Now().Add(writeTimeout))
+			if err := conn.WriteMessage(websocket.TextMessage, data); err != nil {
+				log.WithField("remote", r.RemoteAddr).Debugf("Failed writing to websocket: %s", err)
+				return false
+			}
+			return true
+		}
+		if !complete && !write(gapMessage) {
+			return
+		}
+		for _, msg := range backlog {
+			if !write(msg.data) {
+				return
+			}
+		}
+
+		heartbeat := time.NewTicker(heartbeatInterval)
+		defer heartbeat.Stop()
+		for {
+			select {
+			case msg, ok := <-c.messages:
+				if !ok {
+					conn.WriteControl(websocket.CloseMessage,
+						websocket.FormatCloseMessage(websocket.CloseGoingAway, ""), time.Now().Add(writeTimeout))
+					return
+				}
+				if !write(msg.data) {
+					return
+				}
+			case <-heartbeat.C:
+				if err := conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(writeTimeout)); err != nil {
+					return
+				}
+			case <-disconnected:
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,240 @@
+// Package stream pushes monitor events to HTTP clients over Server-Sent
+// Events and WebSocket.
+//
+// Every event gets a resume token. Clients reconnecting with the token of the
+// last event they received get the events they missed, as long as those are
+// still held by the in-memory ring buffer of the Hub.
+package stream
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"hw-3/sink"
+)
+
+const clientQueueSize = 256
+
+// message is a record as sent to clients, tagged with its resume token.
+type message struct {
+	id   string
+	typ  string
+	rec  *sink.Record
+	data []byte
+}
+
+// Filter selects the records a client receives: those of the listed feeds,
+// matched by tokens or proxy address, and block records if Blocks is set.
+// An empty filter selects everything.
+type Filter struct {
+	Feeds  []string
+	Blocks bool
+}
+
+func (f Filter) empty() bool {
+	return len(f.Feeds) == 0 && !f.Blocks
+}
+
+func (f Filter) match(rec *sink.Record) bool {
+	if f.empty() {
+		return true
+	}
+	switch rec.Type {
+	case "block", "gas", "blockError":
+		return f.Blocks
+	case "subscribed", "contractEvent", "contractError":
+		return false
+	}
+	for _, feed := range f.Feeds {
+		if feed == rec.Subject || strings.EqualFold(feed, rec.Address) {
+			return true
+		}
+	}
+	return false
+}
+
+type client struct {
+	filter   Filter
+	messages chan *message
+	// closed is set once the client is dropped, either because the hub was
+	// closed or because the client could not keep up.
+	closed bool
+}
+
+// Hub is a sink keeping the latest records in a ring buffer and fanning them
+// out to connected clients. Clients that cannot keep up are disconnected,
+// they can resume where they left off.
+type Hub struct {
+	epoch string
+
+	mu      sync.Mutex
+	ring    []*message
+	next    uint64
+	clients map[*client]struct{}
+	closed  bool
+}
+
+// NewHub creates a Hub remembering the last size records, at least one.
+func NewHub(size int) *Hub {
+	if size < 1 {
+		size = 1
+	}
+	return &Hub{
+		// Tokens of a previous process must not resume at a position of this one
+		epoch:   strconv.FormatInt(time.Now().UnixNano(), 36),
+		ring:    make([]*message, size),
+		clients: make(map[*client]struct{}),
+	}
+}
+
+// Send assigns rec the next resume token and delivers it to all clients
+// whose filter it matches. It never blocks.
+func (h *Hub) Send(rec *sink.Record) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.closed {
+		return nil
+	}
+
+	msg := &message{id: h.token(h.next), typ: rec.Type, rec: rec}
+	data, err := json.Marshal(struct {
+		ID string `json:"id"`
+		*sink.Record
+	}{msg.id, rec})
+	if err != nil {
+		return fmt.Errorf("failed encoding record: %w", err)
+	}
+	msg.data = data
+	h.ring[h.next%uint64(len(h.ring))] = msg
+	h.next++
+
+	for c := range h.clients {
+		if !c.filter.match(rec) {
+			continue
+		}
+		select {
+		case c.messages <- msg:
+		default:
+			h.drop(c)
+		}
+	}
+	return nil
+}
+
+// Close disconnects all clients.
+func (h *Hub) Close() error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.closed = true
+	for c := range h.clients {
+		h.drop(c)
+	}
+	return nil
+}
+
+func (h *Hub) token(seq uint64) string {
+	return h.epoch + "-" + strconv.FormatUint(seq, 10)
+}
+
+// parseToken returns the position after the record with the given token.
+func (h *Hub) parseToken(token string) (uint64, bool) {
+	epoch, seq, found := strings.Cut(token, "-")
+	if !found || epoch != h.epoch {
+		return 0, false
+	}
+	parsed, err := strconv.ParseUint(seq, 10, 64)
+	if err != nil {
+		return 0, false
+	}
+	return parsed + 1, true
+}
+
+// subscribe registers a client and returns the records it missed since the
+// record with the resume token. Complete is false if some of them are no
+// longer buffered or the token is unknown, an empty token asks for no
+// backlog at all.
+func (h *Hub) subscribe(filter Filter, resume string) (c *client, backlog []*message, complete bool) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	c = &client{filter: filter, messages: make(chan *message, clientQueueSize)}
+	if h.closed {
+		c.closed = true
+		close(c.messages)
+		return c, nil, true
+	}
+	h.clients[c] = struct{}{}
+
+	if resume == "" {
+		return c, nil, true
+	}
+	oldest := uint64(0)
+	if h.next > uint64(len(h.ring)) {
+		oldest = h.next - uint64(len(h.ring))
+	}
+	from, ok := h.parseToken(resume)
+	complete = ok && from >= oldest && from <= h.next
+	if !complete {
+		from = oldest
+	}
+	for seq := from; seq < h.next; seq++ {
+		if msg := h.ring[seq%uint64(len(h.ring))]; filter.match(msg.rec) {
+			backlog = append(backlog, msg)
+		}
+	}
+	return c, backlog, complete
+}
+
+// Subscription receives the records sent to a Hub after it subscribed.
+type Subscription struct {
+	hub    *Hub
+	client *client
+}
+
+// Subscribe registers an in-process subscriber for the records matching
+// filter. Like other clients it is dropped when it falls behind, Unsubscribe
+// has to be called once it is no longer read.
+func (h *Hub) Subscribe(filter Filter) *Subscription {
+	c, _, _ := h.subscribe(filter, "")
+	return &Subscription{hub: h, client: c}
+}
+
+// Next waits for the next record. It returns false once the subscriber was
+// dropped or ctx is done.
+func (s *Subscription) Next(ctx context.Context) (*sink.Record, bool) {
+	select {
+	case msg, ok := <-s.client.messages:
+		if !ok {
+			return nil, false
+		}
+		return msg.rec, true
+	case <-ctx.Done():
+		return nil, false
+	}
+}
+
+// Unsubscribe stops delivering records to the subscriber.
+func (s *Subscription) Unsubscribe() {
+	s.hub.unsubscribe(s.client)
+}
+
+func (h *Hub) unsubscribe(c *client) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.drop(c)
+}
+
+// drop has to be called with the lock held.
+func (h *Hub) drop(c *client) {
+	if c.closed {
+		return
+	}
+	c.closed = true
+	delete(h.clients, c)
+	close(c.messages)
+}
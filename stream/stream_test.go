@@ -0,0 +1,213 @@
+package stream
+
+import (
+	"bufio"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"hw-3/sink"
+)
+
+const readTimeout = 5 * time.Second
+
+type sseEvent struct {
+	id   string
+	name string
+	data string
+}
+
+type sseClient struct {
+	resp   *http.Response
+	reader *bufio.Reader
+}
+
+func dialSSE(t *testing.T, server *httptest.Server, query, lastEventID string) *sseClient {
+	t.Helper()
+	req, err := http.NewRequest(http.MethodGet, server.URL+"/stream?"+query, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if lastEventID != "" {
+		req.Header.Set("Last-Event-ID", lastEventID)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { resp.Body.Close() })
+	if got := resp.Header.Get("Content-Type"); got != "text/event-stream" {
+		t.Fatalf("unexpected content type %s", got)
+	}
+	return &sseClient{resp: resp, reader: bufio.NewReader(resp.Body)}
+}
+
+func (c *sseClient) next(t *testing.T) sseEvent {
+	t.Helper()
+	ev := sseEvent{}
+	for {
+		line, err := c.reader.ReadString('\n')
+		if err != nil {
+			t.Fatal(err)
+		}
+		line = strings.TrimSuffix(line, "\n")
+		switch {
+		case line == "":
+			if ev.name != "" {
+				return ev
+			}
+		case strings.HasPrefix(line, "id: "):
+			ev.id = strings.TrimPrefix(line, "id: ")
+		case strings.HasPrefix(line, "event: "):
+			ev.name = strings.TrimPrefix(line, "event: ")
+		case strings.HasPrefix(line, "data: "):
+			ev.data = strings.TrimPrefix(line, "data: ")
+		}
+	}
+}
+
+func newServer(t *testing.T, hub *Hub) *httptest.Server {
+	t.Helper()
+	mux := http.NewServeMux()
+	mux.Handle("/stream", hub.SSEHandler())
+	mux.Handle("/ws", hub.WebsocketHandler())
+	server := httptest.NewServer(mux)
+	t.Cleanup(func() {
+		hub.Close()
+		server.Close()
+	})
+	return server
+}
+
+// waitClients waits until count clients are subscribed, so records sent
+// afterwards are delivered live.
+func waitClients(t *testing.T, hub *Hub, count int) {
+	t.Helper()
+	deadline := time.Now().Add(readTimeout)
+	for {
+		hub.mu.Lock()
+		connected := len(hub.clients)
+		hub.mu.Unlock()
+		if connected == count {
+			return
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("expected %d clients, got %d", count, connected)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func price(tokens, value string) *sink.Record {
+	return &sink.Record{Schema: sink.SchemaVersion, Type: "price", Subject: tokens, Value: value}
+}
+
+func block(number string) *sink.Record {
+	return &sink.Record{Schema: sink.SchemaVersion, Type: "block", Value: number}
+}
+
+func TestSSEResume(t *testing.T) {
+	hub := NewHub(16)
+	server := newServer(t, hub)
+
+	client := dialSSE(t, server, url.Values{"feed": {"ETH / USD"}}.Encode(), "")
+	waitClients(t, hub, 1)
+	hub.Send(price("ETH / USD", "1500"))
+	hub.Send(price("LINK / ETH", "0.005"))
+	hub.Send(block("15884853"))
+	hub.Send(price("ETH / USD", "1501"))
+
+	first := client.next(t)
+	second := client.next(t)
+	if first.name != "price" || !strings.Contains(first.data, `"value":"1500"`) {
+		t.Errorf("unexpected first event %+v", first)
+	}
+	if !strings.Contains(second.data, `"value":"1501"`) {
+		t.Errorf("expected only ETH / USD prices, got %+v", second)
+	}
+	var decoded struct {
+		ID     string `json:"id"`
+		Schema int    `json:"schema"`
+	}
+	if err := json.Unmarshal([]byte(second.data), &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if decoded.ID != second.id || decoded.Schema != sink.SchemaVersion {
+		t.Errorf("expected id %s and schema %d in data, got %s", second.id, sink.SchemaVersion, second.data)
+	}
+
+	client.resp.Body.Close()
+	waitClients(t, hub, 0)
+	hub.Send(price("ETH / USD", "1502"))
+
+	resumed := dialSSE(t, server, url.Values{"feed": {"ETH / USD"}}.Encode(), first.id)
+	for _, expected := range []string{"1501", "1502"} {
+		ev := resumed.next(t)
+		if !strings.Contains(ev.data, `"value":"`+expected+`"`) {
+			t.Errorf("expected missed price %s, got %+v", expected, ev)
+		}
+	}
+}
+
+func TestWebsocketGap(t *testing.T) {
+	hub := NewHub(2)
+	server := newServer(t, hub)
+	for _, number := range []string{"1", "2", "3"} {
+		hub.Send(block(number))
+	}
+
+	wsURL := "ws" + strings.TrimPrefix(server.URL, "http") + "/ws?blocks&resume=stale-0"
+	conn, _, err := websocket.DefaultDialer.Dial(wsURL, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	conn.SetReadDeadline(time.Now().Add(readTimeout))
+
+	read := func() map[string]interface{} {
+		t.Helper()
+		_, data, err := conn.ReadMessage()
+		if err != nil {
+			t.Fatal(err)
+		}
+		decoded := make(map[string]interface{})
+		if err := json.Unmarshal(data, &decoded); err != nil {
+			t.Fatal(err)
+		}
+		return decoded
+	}
+	if msg := read(); msg["type"] != "gap" {
+		t.Errorf("expected gap for a stale token, got %v", msg)
+	}
+	for _, expected := range []string{"2", "3"} {
+		if msg := read(); msg["value"] != expected {
+			t.Errorf("expected buffered block %s, got %v", expected, msg)
+		}
+	}
+
+	hub.Send(price("ETH / USD", "1500"))
+	hub.Send(block("4"))
+	if msg := read(); msg["value"] != "4" {
+		t.Errorf("expected live block 4, got %v", msg)
+	}
+}
+
+func TestSlowClientDropped(t *testing.T) {
+	hub := NewHub(1)
+	c, _, _ := hub.subscribe(Filter{}, "")
+	for i := 0; i <= clientQueueSize; i++ {
+		hub.Send(block("1"))
+	}
+	received := 0
+	for range c.messages {
+		received++
+	}
+	if received != clientQueueSize {
+		t.Errorf("expected %d queued records before the client is dropped, got %d", clientQueueSize, received)
+	}
+}
@@ -0,0 +1,343 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package uniswap
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+)
+
+// V3PoolMetaData contains all meta data concerning the V3Pool contract.
+var V3PoolMetaData = &bind.MetaData{
+	ABI: "[{\"inputs\":[],\"name\":\"slot0\",\"outputs\":[{\"internalType\":\"uint160\",\"name\":\"sqrtPriceX96\",\"type\":\"uint160\"},{\"internalType\":\"int24\",\"name\":\"tick\",\"type\":\"int24\"},{\"internalType\":\"uint16\",\"name\":\"observationIndex\",\"type\":\"uint16\"},{\"internalType\":\"uint16\",\"name\":\"observationCardinality\",\"type\":\"uint16\"},{\"internalType\":\"uint16\",\"name\":\"observationCardinalityNext\",\"type\":\"uint16\"},{\"internalType\":\"uint8\",\"name\":\"feeProtocol\",\"type\":\"uint8\"},{\"internalType\":\"bool\",\"name\":\"unlocked\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"token0\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"token1\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"fee\",\"outputs\":[{\"internalType\":\"uint24\",\"name\":\"\",\"type\":\"uint24\"}],\"stateMutability\":\"view\",\"type\":\"function\"}]",
+}
+
+// V3PoolABI is the input ABI used to generate the binding from.
+// Deprecated: Use V3PoolMetaData.ABI instead.
+var V3PoolABI = V3PoolMetaData.ABI
+
+// V3Pool is an auto generated Go binding around an Ethereum contract.
+type V3Pool struct {
+	V3PoolCaller     // Read-only binding to the contract
+	V3PoolTransactor // Write-only binding to the contract
+	V3PoolFilterer   // Log filterer for contract events
+}
+
+// V3PoolCaller is an auto generated read-only Go binding around an Ethereum contract.
+type V3PoolCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// V3PoolTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type V3PoolTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// V3PoolFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type V3PoolFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// V3PoolSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type V3PoolSession struct {
+	Contract     *V3Pool           // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// V3PoolCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type V3PoolCallerSession struct {
+	Contract *V3PoolCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts // Call options to use throughout this session
+}
+
+// V3PoolTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type V3PoolTransactorSession struct {
+	Contract     *V3PoolTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// V3PoolRaw is an auto generated low-level Go binding around an Ethereum contract.
+type V3PoolRaw struct {
+	Contract *V3Pool // Generic contract binding to access the raw methods on
+}
+
+// V3PoolCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type V3PoolCallerRaw struct {
+	Contract *V3PoolCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// V3PoolTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type V3PoolTransactorRaw struct {
+	Contract *V3PoolTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewV3Pool creates a new instance of V3Pool, bound to a specific deployed contract.
+func NewV3Pool(address common.Address, backend bind.ContractBackend) (*V3Pool, error) {
+	contract, err := bindV3Pool(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &V3Pool{V3PoolCaller: V3PoolCaller{contract: contract}, V3PoolTransactor: V3PoolTransactor{contract: contract}, V3PoolFilterer: V3PoolFilterer{contract: contract}}, nil
+}
+
+// NewV3PoolCaller creates a new read-only instance of V3Pool, bound to a specific deployed contract.
+func NewV3PoolCaller(address common.Address, caller bind.ContractCaller) (*V3PoolCaller, error) {
+	contract, err := bindV3Pool(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &V3PoolCaller{contract: contract}, nil
+}
+
+// NewV3PoolTransactor creates a new write-only instance of V3Pool, bound to a specific deployed contract.
+func NewV3PoolTransactor(address common.Address, transactor bind.ContractTransactor) (*V3PoolTransactor, error) {
+	contract, err := bindV3Pool(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &V3PoolTransactor{contract: contract}, nil
+}
+
+// NewV3PoolFilterer creates a new log filterer instance of V3Pool, bound to a specific deployed contract.
+func NewV3PoolFilterer(address common.Address, filterer bind.ContractFilterer) (*V3PoolFilterer, error) {
+	contract, err := bindV3Pool(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &V3PoolFilterer{contract: contract}, nil
+}
+
+// bindV3Pool binds a generic wrapper to an already deployed contract.
+func bindV3Pool(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := abi.JSON(strings.NewReader(V3PoolABI))
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_V3Pool *V3PoolRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _V3Pool.Contract.V3PoolCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_V3Pool *V3PoolRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _V3Pool.Contract.V3PoolTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_V3Pool *V3PoolRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _V3Pool.Contract.V3PoolTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_V3Pool *V3PoolCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _V3Pool.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_V3Pool *V3PoolTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _V3Pool.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_V3Pool *V3PoolTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _V3Pool.Contract.contract.Transact(opts, method, params...)
+}
+
+// Fee is a free data retrieval call binding the contract method 0xddca3f43.
+//
+// Solidity: function fee() view returns(uint24)
+func (_V3Pool *V3PoolCaller) Fee(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _V3Pool.contract.Call(opts, &out, "fee")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// Fee is a free data retrieval call binding the contract method 0xddca3f43.
+//
+// Solidity: function fee() view returns(uint24)
+func (_V3Pool *V3PoolSession) Fee() (*big.Int, error) {
+	return _V3Pool.Contract.Fee(&_V3Pool.CallOpts)
+}
+
+// Fee is a free data retrieval call binding the contract method 0xddca3f43.
+//
+// Solidity: function fee() view returns(uint24)
+func (_V3Pool *V3PoolCallerSession) Fee() (*big.Int, error) {
+	return _V3Pool.Contract.Fee(&_V3Pool.CallOpts)
+}
+
+// Slot0 is a free data retrieval call binding the contract method 0x3850c7bd.
+//
+// Solidity: function slot0() view returns(uint160 sqrtPriceX96, int24 tick, uint16 observationIndex, uint16 observationCardinality, uint16 observationCardinalityNext, uint8 feeProtocol, bool unlocked)
+func (_V3Pool *V3PoolCaller) Slot0(opts *bind.CallOpts) (struct {
+	SqrtPriceX96               *big.Int
+	Tick                       *big.Int
+	ObservationIndex           uint16
+	ObservationCardinality     uint16
+	ObservationCardinalityNext uint16
+	FeeProtocol                uint8
+	Unlocked                   bool
+}, error) {
+	var out []interface{}
+	err := _V3Pool.contract.Call(opts, &out, "slot0")
+
+	outstruct := new(struct {
+		SqrtPriceX96               *big.Int
+		Tick                       *big.Int
+		ObservationIndex           uint16
+		ObservationCardinality     uint16
+		ObservationCardinalityNext uint16
+		FeeProtocol                uint8
+		Unlocked                   bool
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.SqrtPriceX96 = *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+	outstruct.Tick = *abi.ConvertType(out[1], new(*big.Int)).(**big.Int)
+	outstruct.ObservationIndex = *abi.ConvertType(out[2], new(uint16)).(*uint16)
+	outstruct.ObservationCardinality = *abi.ConvertType(out[3], new(uint16)).(*uint16)
+	outstruct.ObservationCardinalityNext = *abi.ConvertType(out[4], new(uint16)).(*uint16)
+	outstruct.FeeProtocol = *abi.ConvertType(out[5], new(uint8)).(*uint8)
+	outstruct.Unlocked = *abi.ConvertType(out[6], new(bool)).(*bool)
+
+	return *outstruct, err
+
+}
+
+// Slot0 is a free data retrieval call binding the contract method 0x3850c7bd.
+//
+// Solidity: function slot0() view returns(uint160 sqrtPriceX96, int24 tick, uint16 observationIndex, uint16 observationCardinality, uint16 observationCardinalityNext, uint8 feeProtocol, bool unlocked)
+func (_V3Pool *V3PoolSession) Slot0() (struct {
+	SqrtPriceX96               *big.Int
+	Tick                       *big.Int
+	ObservationIndex           uint16
+	ObservationCardinality     uint16
+	ObservationCardinalityNext uint16
+	FeeProtocol                uint8
+	Unlocked                   bool
+}, error) {
+	return _V3Pool.Contract.Slot0(&_V3Pool.CallOpts)
+}
+
+// Slot0 is a free data retrieval call binding the contract method 0x3850c7bd.
+//
+// Solidity: function slot0() view returns(uint160 sqrtPriceX96, int24 tick, uint16 observationIndex, uint16 observationCardinality, uint16 observationCardinalityNext, uint8 feeProtocol, bool unlocked)
+func (_V3Pool *V3PoolCallerSession) Slot0() (struct {
+	SqrtPriceX96               *big.Int
+	Tick                       *big.Int
+	ObservationIndex           uint16
+	ObservationCardinality     uint16
+	ObservationCardinalityNext uint16
+	FeeProtocol                uint8
+	Unlocked                   bool
+}, error) {
+	return _V3Pool.Contract.Slot0(&_V3Pool.CallOpts)
+}
+
+// Token0 is a free data retrieval call binding the contract method 0x0dfe1681.
+//
+// Solidity: function token0() view returns(address)
+func (_V3Pool *V3PoolCaller) Token0(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _V3Pool.contract.Call(opts, &out, "token0")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Token0 is a free data retrieval call binding the contract method 0x0dfe1681.
+//
+// Solidity: function token0() view returns(address)
+func (_V3Pool *V3PoolSession) Token0() (common.Address, error) {
+	return _V3Pool.Contract.Token0(&_V3Pool.CallOpts)
+}
+
+// Token0 is a free data retrieval call binding the contract method 0x0dfe1681.
+//
+// Solidity: function token0() view returns(address)
+func (_V3Pool *V3PoolCallerSession) Token0() (common.Address, error) {
+	return _V3Pool.Contract.Token0(&_V3Pool.CallOpts)
+}
+
+// Token1 is a free data retrieval call binding the contract method 0xd21220a7.
+//
+// Solidity: function token1() view returns(address)
+func (_V3Pool *V3PoolCaller) Token1(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _V3Pool.contract.Call(opts, &out, "token1")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Token1 is a free data retrieval call binding the contract method 0xd21220a7.
+//
+// Solidity: function token1() view returns(address)
+func (_V3Pool *V3PoolSession) Token1() (common.Address, error) {
+	return _V3Pool.Contract.Token1(&_V3Pool.CallOpts)
+}
+
+// Token1 is a free data retrieval call binding the contract method 0xd21220a7.
+//
+// Solidity: function token1() view returns(address)
+func (_V3Pool *V3PoolCallerSession) Token1() (common.Address, error) {
+	return _V3Pool.Contract.Token1(&_V3Pool.CallOpts)
+}